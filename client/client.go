@@ -5,23 +5,41 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/feegrant"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	txconfig "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1"
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1/injective"
 	"github.com/riccardom/cosmos-go-wallet/types"
+	"github.com/riccardom/cosmos-go-wallet/types/ethermint"
+	injectivetypes "github.com/riccardom/cosmos-go-wallet/types/injective"
 )
 
+// DefaultTxPollInterval is the default interval between each query performed when waiting for a transaction
+const DefaultTxPollInterval = time.Second
+
 // Client represents a Cosmos client that should be used to interact with a chain
 type Client struct {
 	prefix string
@@ -32,23 +50,32 @@ type Client struct {
 	txConfig  sdkclient.TxConfig
 	txEncoder sdk.TxEncoder
 
-	authClient authtypes.QueryClient
-	bankClient banktypes.QueryClient
-	txClient   sdktx.ServiceClient
+	authClient     authtypes.QueryClient
+	bankClient     banktypes.QueryClient
+	feegrantClient feegrant.QueryClient
+	txClient       sdktx.ServiceClient
+
+	gasPriceProvider GasPriceProvider
+	gasAdjustment    float64
 
-	gasPrice      sdk.DecCoin
-	gasAdjustment float64
+	// txPollInterval is the interval between each query when waiting for a transaction to be included in a block
+	txPollInterval time.Duration
 }
 
-// NewClient allows to build a new Client instance
+// NewClient allows to build a new Client instance.
+// The given gas prices contain one price for each accepted fee denom, sorted by preference.
+// The public keys and accounts types of the supported EVM based chains (e.g. Evmos and Injective) are registered
+// on the interface registry of the given codec, unless other types are already registered using the same type URLs
 func NewClient(
 	bech32Prefix string,
-	gasPrice sdk.DecCoin,
+	gasPrices sdk.DecCoins,
 	rpcClient *rpchttp.HTTP,
 	grpcConn grpc.ClientConnInterface,
 	txConfig sdkclient.TxConfig,
 	codec codec.Codec,
 ) *Client {
+	registerInterfaces(codec.InterfaceRegistry())
+
 	return &Client{
 		prefix: bech32Prefix,
 
@@ -58,12 +85,33 @@ func NewClient(
 		txEncoder: tx.DefaultTxEncoder(),
 		txConfig:  txConfig,
 
-		authClient: authtypes.NewQueryClient(grpcConn),
-		bankClient: banktypes.NewQueryClient(grpcConn),
-		txClient:   sdktx.NewServiceClient(grpcConn),
+		authClient:     authtypes.NewQueryClient(grpcConn),
+		bankClient:     banktypes.NewQueryClient(grpcConn),
+		feegrantClient: feegrant.NewQueryClient(grpcConn),
+		txClient:       sdktx.NewServiceClient(grpcConn),
+
+		gasPriceProvider: NewStaticGasPriceProvider(gasPrices),
+		gasAdjustment:    1.5,
+
+		txPollInterval: DefaultTxPollInterval,
+	}
+}
+
+// registerInterfaces registers on the given registry the public keys and accounts types used by the supported
+// EVM based chains, so that their transactions and accounts can be decoded
+func registerInterfaces(registry codectypes.InterfaceRegistry) {
+	registerImplementations(registry, (*cryptotypes.PubKey)(nil), &ethsecp256k1.PubKey{}, &injective.PubKey{})
+	registerImplementations(registry, (*sdk.AccountI)(nil), &ethermint.EthAccount{}, &injectivetypes.EthAccount{})
+}
 
-		gasPrice:      gasPrice,
-		gasAdjustment: 1.5,
+// registerImplementations registers the given implementations of the provided interface,
+// skipping the ones whose type URL has already been registered (e.g. by the chain codec)
+func registerImplementations(registry codectypes.InterfaceRegistry, iface interface{}, impls ...gogoproto.Message) {
+	for _, impl := range impls {
+		_, err := registry.Resolve(sdk.MsgTypeURL(impl))
+		if err != nil {
+			registry.RegisterImplementations(iface, impl)
+		}
 	}
 }
 
@@ -79,20 +127,58 @@ func NewClientFromConfig(config *types.ChainConfig, txConfig sdkclient.TxConfig,
 		return nil, fmt.Errorf("error while creating a GRPC connection: %s", err)
 	}
 
-	gasPrice, err := sdk.ParseDecCoin(config.GasPrice)
+	gasPrices, err := getGasPrices(config, grpcConn)
 	if err != nil {
-		return nil, fmt.Errorf("error while parsing gas price: %s", err)
+		return nil, err
 	}
 
 	// Build the client
-	cosmosClient := NewClient(config.Bech32Prefix, gasPrice, rpcClient, grpcConn, txConfig, codec)
+	cosmosClient := NewClient(config.Bech32Prefix, gasPrices, rpcClient, grpcConn, txConfig, codec)
 
 	// Set the options based on the config
 	cosmosClient = cosmosClient.WithGasAdjustment(config.GasAdjustment)
 
+	if config.UseFeeMarket {
+		denoms := make([]string, len(gasPrices))
+		for i, gasPrice := range gasPrices {
+			denoms[i] = gasPrice.Denom
+		}
+		cosmosClient = cosmosClient.WithGasPriceProvider(NewFeeMarketGasPriceProvider(grpcConn, denoms...))
+	}
+
+	if config.EnableSignModeTextual {
+		cosmosClient, err = cosmosClient.WithSignModeTextual()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return cosmosClient, nil
 }
 
+// getGasPrices returns the gas prices that should be used based on the given configuration.
+// If no gas price is configured, the minimum gas prices accepted by the node are used instead
+func getGasPrices(config *types.ChainConfig, grpcConn grpc.ClientConnInterface) (sdk.DecCoins, error) {
+	if strings.TrimSpace(config.GasPrice) != "" {
+		gasPrices, err := ParseGasPrices(config.GasPrice)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing gas price: %s", err)
+		}
+		return gasPrices, nil
+	}
+
+	gasPrices, err := QueryMinimumGasPrices(grpcConn)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the minimum gas prices: %s", err)
+	}
+
+	if len(gasPrices) == 0 {
+		return nil, fmt.Errorf("error while getting the minimum gas prices: node has no minimum gas prices, please set the gas price explicitly")
+	}
+
+	return gasPrices, nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // WithGasAdjustment allows to set the gas adjustment factor to be used when simulating transactions
@@ -101,6 +187,82 @@ func (c *Client) WithGasAdjustment(gasAdjustment float64) *Client {
 	return c
 }
 
+// WithGasPriceProvider allows to set the provider of the gas price to be used when computing the fees.
+// By default, the gas price provided when creating the client is always used
+func (c *Client) WithGasPriceProvider(provider GasPriceProvider) *Client {
+	c.gasPriceProvider = provider
+	return c
+}
+
+// WithTxPollInterval allows to set the interval between each query that is performed
+// when waiting for a transaction to be included inside a block.
+// If the interval is not positive, DefaultTxPollInterval is used instead
+func (c *Client) WithTxPollInterval(interval time.Duration) *Client {
+	if interval <= 0 {
+		interval = DefaultTxPollInterval
+	}
+	c.txPollInterval = interval
+	return c
+}
+
+// WithSignModeTextual allows to enable SIGN_MODE_TEXTUAL by replacing the transaction config of this client with
+// a new one that uses the gRPC connection to query the coins metadata required to render the sign documents.
+// The new transaction config keeps the sign modes, signing context, encoders and decoders of the current one
+func (c *Client) WithSignModeTextual() (*Client, error) {
+	handlerMap := c.txConfig.SignModeHandler()
+
+	handlers := make([]txsigning.SignModeHandler, 0, len(handlerMap.SupportedModes())+1)
+	for _, mode := range handlerMap.SupportedModes() {
+		if mode == signingv1beta1.SignMode_SIGN_MODE_TEXTUAL {
+			// SIGN_MODE_TEXTUAL is already enabled
+			return c, nil
+		}
+		handlers = append(handlers, signModeHandler{mode: mode, handlerMap: handlerMap})
+	}
+
+	signingContext := c.txConfig.SigningContext()
+	textualHandler, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: txconfig.NewGRPCCoinMetadataQueryFn(c.grpcConn),
+		FileResolver:        signingContext.FileResolver(),
+		TypeResolver:        signingContext.TypeResolver(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while creating textual sign mode handler: %s", err)
+	}
+
+	txConfig, err := tx.NewTxConfigWithOptions(c.codec, tx.ConfigOptions{
+		SigningHandler: txsigning.NewHandlerMap(append(handlers, textualHandler)...),
+		SigningContext: signingContext,
+		ProtoDecoder:   c.txConfig.TxDecoder(),
+		ProtoEncoder:   c.txConfig.TxEncoder(),
+		JSONDecoder:    c.txConfig.TxJSONDecoder(),
+		JSONEncoder:    c.txConfig.TxJSONEncoder(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while creating textual tx config: %s", err)
+	}
+
+	c.txConfig = txConfig
+	return c, nil
+}
+
+// signModeHandler is a txsigning.SignModeHandler that delegates to an existing handler map
+// the computation of the sign bytes for a single sign mode
+type signModeHandler struct {
+	mode       signingv1beta1.SignMode
+	handlerMap *txsigning.HandlerMap
+}
+
+// Mode implements txsigning.SignModeHandler
+func (h signModeHandler) Mode() signingv1beta1.SignMode {
+	return h.mode
+}
+
+// GetSignBytes implements txsigning.SignModeHandler
+func (h signModeHandler) GetSignBytes(ctx context.Context, signerData txsigning.SignerData, txData txsigning.TxData) ([]byte, error) {
+	return h.handlerMap.GetSignBytes(ctx, h.mode, signerData, txData)
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetRPCClient returns the RPC client associated to this client
@@ -161,14 +323,48 @@ func (c *Client) GetChainID() (string, error) {
 	return res.NodeInfo.Network, nil
 }
 
-// GetFeeDenom returns the denom used to pay for fees, based on the gas price inside the config
+// GetLatestHeight returns the height of the latest block of the chain
+func (c *Client) GetLatestHeight() (int64, error) {
+	res, err := c.rpcClient.Status(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("error while getting latest height: %s", err)
+	}
+
+	return res.SyncInfo.LatestBlockHeight, nil
+}
+
+// GetFeeDenom returns the preferred denom used to pay for fees, based on the gas prices returned by the
+// gas price provider. An empty string is returned if the gas prices cannot be read
 func (c *Client) GetFeeDenom() string {
-	return c.gasPrice.Denom
+	gasPrices, err := c.GetGasPrices()
+	if err != nil || len(gasPrices) == 0 {
+		return ""
+	}
+	return gasPrices[0].Denom
 }
 
-// GetFees returns the fees that should be paid to perform a transaction with the given gas
-func (c *Client) GetFees(gas int64) sdk.Coins {
-	return sdk.NewCoins(sdk.NewCoin(c.gasPrice.Denom, c.gasPrice.Amount.MulInt64(gas).Ceil().RoundInt()))
+// GetGasPrices returns the gas prices that should currently be used to pay for fees, sorted by preference
+func (c *Client) GetGasPrices() (sdk.DecCoins, error) {
+	gasPrices, err := c.gasPriceProvider.GetGasPrices()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the gas prices: %s", err)
+	}
+	return gasPrices, nil
+}
+
+// GetFees returns the fees that should be paid to perform a transaction with the given gas,
+// based on the current preferred gas price returned by the gas price provider
+func (c *Client) GetFees(gas int64) (sdk.Coins, error) {
+	gasPrices, err := c.GetGasPrices()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(gasPrices) == 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	return types.ComputeFees(gasPrices[0], uint64(gas)), nil
 }
 
 // GetAccount returns the details of the account having the given address reading it from the chain
@@ -197,6 +393,36 @@ func (c *Client) GetBalances(address string) (sdk.Coins, error) {
 	return res.Balances, nil
 }
 
+// GetSpendableBalances returns the balances of the account having the given address that can currently be spent,
+// excluding the tokens that are locked (e.g. by a vesting schedule)
+func (c *Client) GetSpendableBalances(address string) (sdk.Coins, error) {
+	res, err := c.bankClient.SpendableBalances(context.Background(), &banktypes.QuerySpendableBalancesRequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Balances, nil
+}
+
+// GetFeeAllowance returns the fee allowance that the given granter has given to the provided grantee
+func (c *Client) GetFeeAllowance(granter string, grantee string) (feegrant.FeeAllowanceI, error) {
+	res, err := c.feegrantClient.Allowance(context.Background(), &feegrant.QueryAllowanceRequest{
+		Granter: granter,
+		Grantee: grantee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var allowance feegrant.FeeAllowanceI
+	err = c.codec.UnpackAny(res.Allowance.Allowance, &allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance, nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SimulateTx simulates the execution of the given transaction, and returns the adjusted
@@ -217,6 +443,41 @@ func (c *Client) SimulateTx(tx signing.Tx) (uint64, error) {
 	return uint64(math.Ceil(c.gasAdjustment * float64(simRes.GasInfo.GasUsed))), nil
 }
 
+// GetTx returns the response of the transaction having the given hash, reading it from the chain
+func (c *Client) GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	res, err := c.txClient.GetTx(ctx, &sdktx.GetTxRequest{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.TxResponse, nil
+}
+
+// WaitForTx waits for the transaction having the given hash to be included inside a block, and then returns its
+// response. It returns an error if the transaction is not included before the given context is done
+func (c *Client) WaitForTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	ticker := time.NewTicker(c.txPollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := c.GetTx(ctx, hash)
+		if err == nil {
+			return res, nil
+		}
+
+		// Return any error that is not due to the transaction not being included yet
+		if status.Code(err) != codes.NotFound && ctx.Err() == nil {
+			return nil, fmt.Errorf("error while getting transaction %s: %s", hash, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("error while waiting for transaction %s: %s", hash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
 // BroadcastTxAsync allows to broadcast a transaction containing the given messages using the sync method
 func (c *Client) BroadcastTxAsync(tx signing.Tx) (*sdk.TxResponse, error) {
 	bytes, err := c.txEncoder(tx)
@@ -0,0 +1,135 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/proto"
+
+	"github.com/riccardom/cosmos-go-wallet/client"
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1"
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1/injective"
+	"github.com/riccardom/cosmos-go-wallet/types/ethermint"
+	injectivetypes "github.com/riccardom/cosmos-go-wallet/types/injective"
+)
+
+func TestClient_GetAccount_EthAccount(t *testing.T) {
+	ethermintPubKey := ethsecp256k1.GenPrivKey().PubKey()
+	ethermintAccount := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(ethermintPubKey.Address()), ethermintPubKey, 10, 5),
+		CodeHash:    "0x01",
+	}
+
+	injectivePubKey := injective.GenPrivKey().PubKey()
+	injectiveAccount := &injectivetypes.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(injectivePubKey.Address()), injectivePubKey, 10, 5),
+		CodeHash:    []byte{0x01},
+	}
+
+	testCases := []struct {
+		name    string
+		account sdk.AccountI
+	}{
+		{name: "Ethermint account", account: ethermintAccount},
+		{name: "Injective account", account: injectiveAccount},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// Use a codec that does not know about the EVM types, as the one of a standard Cosmos SDK chain
+			registry := codectypes.NewInterfaceRegistry()
+			std.RegisterInterfaces(registry)
+			authtypes.RegisterInterfaces(registry)
+			cdc := codec.NewProtoCodec(registry)
+
+			accountAny, err := codectypes.NewAnyWithValue(tc.account)
+			require.NoError(t, err)
+
+			conn := &mockConn{handlers: map[string]func(req []byte) ([]byte, error){
+				"/cosmos.auth.v1beta1.Query/Account": func(_ []byte) ([]byte, error) {
+					res := &authtypes.QueryAccountResponse{Account: accountAny}
+					return res.Marshal()
+				},
+			}}
+			cosmosClient := client.NewClient("cosmos", nil, nil, conn, nil, cdc)
+
+			// Both the gRPC and the gRPC-over-RPC connections should be able to decode the account
+			for _, connCdc := range []encoding.Codec{encoding.GetCodec(proto.Name), cdc.GRPCCodec()} {
+				conn.cdc = connCdc
+
+				account, err := cosmosClient.GetAccount(tc.account.GetAddress().String())
+				require.NoError(t, err)
+				require.IsType(t, tc.account, account)
+				require.Equal(t, uint64(10), account.GetAccountNumber())
+				require.Equal(t, uint64(5), account.GetSequence())
+				require.True(t, tc.account.GetPubKey().Equals(account.GetPubKey()))
+			}
+		})
+	}
+}
+
+// customSignModeHandler is a txsigning.SignModeHandler that returns fixed sign bytes
+type customSignModeHandler struct{}
+
+func (h customSignModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_EIP_191
+}
+
+func (h customSignModeHandler) GetSignBytes(_ context.Context, _ txsigning.SignerData, _ txsigning.TxData) ([]byte, error) {
+	return []byte("custom"), nil
+}
+
+func TestClient_WithSignModeTextual(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	txConfig, err := authtx.NewTxConfigWithOptions(cdc, authtx.ConfigOptions{
+		EnabledSignModes: []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT},
+		CustomSignModes:  []txsigning.SignModeHandler{customSignModeHandler{}},
+		ProtoEncoder: func(_ sdk.Tx) ([]byte, error) {
+			return []byte("encoded"), nil
+		},
+	})
+	require.NoError(t, err)
+
+	cosmosClient := client.NewClient("cosmos", nil, nil, &mockConn{}, txConfig, cdc)
+	cosmosClient, err = cosmosClient.WithSignModeTextual()
+	require.NoError(t, err)
+
+	expectedModes := []signingv1beta1.SignMode{
+		signingv1beta1.SignMode_SIGN_MODE_DIRECT,
+		signingv1beta1.SignMode_SIGN_MODE_EIP_191,
+		signingv1beta1.SignMode_SIGN_MODE_TEXTUAL,
+	}
+
+	handlerMap := cosmosClient.GetTxConfig().SignModeHandler()
+	require.Equal(t, expectedModes, handlerMap.SupportedModes())
+	require.Equal(t, signingv1beta1.SignMode_SIGN_MODE_DIRECT, handlerMap.DefaultMode())
+
+	// Make sure the custom sign mode and the encoder of the given config are kept
+	signBytes, err := handlerMap.GetSignBytes(context.Background(), signingv1beta1.SignMode_SIGN_MODE_EIP_191, txsigning.SignerData{}, txsigning.TxData{})
+	require.NoError(t, err)
+	require.Equal(t, []byte("custom"), signBytes)
+
+	txBytes, err := cosmosClient.GetTxConfig().TxEncoder()(nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("encoded"), txBytes)
+
+	// Make sure enabling SIGN_MODE_TEXTUAL twice does not change the supported modes
+	cosmosClient, err = cosmosClient.WithSignModeTextual()
+	require.NoError(t, err)
+	require.Equal(t, expectedModes, cosmosClient.GetTxConfig().SignModeHandler().SupportedModes())
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/riccardom/cosmos-go-wallet/types/feemarket"
+	"github.com/riccardom/cosmos-go-wallet/types/globalfee"
+)
+
+// GasPriceProvider represents an object that is able to provide the gas prices that should be used to pay for fees
+type GasPriceProvider interface {
+	// GetGasPrices returns the gas prices that should currently be used, one for each accepted fee denom,
+	// sorted by preference
+	GetGasPrices() (sdk.DecCoins, error)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var (
+	_ GasPriceProvider = &StaticGasPriceProvider{}
+)
+
+// StaticGasPriceProvider represents a GasPriceProvider that always returns the same gas prices
+type StaticGasPriceProvider struct {
+	gasPrices sdk.DecCoins
+}
+
+// NewStaticGasPriceProvider returns a new StaticGasPriceProvider instance
+func NewStaticGasPriceProvider(gasPrices sdk.DecCoins) *StaticGasPriceProvider {
+	return &StaticGasPriceProvider{
+		gasPrices: gasPrices,
+	}
+}
+
+// GetGasPrices implements GasPriceProvider
+func (p *StaticGasPriceProvider) GetGasPrices() (sdk.DecCoins, error) {
+	return p.gasPrices, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var (
+	_ GasPriceProvider = &FeeMarketGasPriceProvider{}
+)
+
+// FeeMarketGasPriceProvider represents a GasPriceProvider that reads the current gas prices from
+// the x/feemarket module, where the base fee changes every block based on the network usage
+type FeeMarketGasPriceProvider struct {
+	feeMarketClient feemarket.QueryClient
+	denoms          []string
+}
+
+// NewFeeMarketGasPriceProvider returns a new FeeMarketGasPriceProvider instance
+// that returns the gas prices of the given denoms
+func NewFeeMarketGasPriceProvider(grpcConn grpc.ClientConnInterface, denoms ...string) *FeeMarketGasPriceProvider {
+	return &FeeMarketGasPriceProvider{
+		feeMarketClient: feemarket.NewQueryClient(grpcConn),
+		denoms:          denoms,
+	}
+}
+
+// GetGasPrices implements GasPriceProvider
+func (p *FeeMarketGasPriceProvider) GetGasPrices() (sdk.DecCoins, error) {
+	gasPrices := make(sdk.DecCoins, len(p.denoms))
+	for i, denom := range p.denoms {
+		res, err := p.feeMarketClient.GasPrice(context.Background(), &feemarket.GasPriceRequest{Denom: denom})
+		if err != nil {
+			return nil, fmt.Errorf("error while querying the fee market gas price of %s: %s", denom, err)
+		}
+		gasPrices[i] = res.Price
+	}
+
+	return gasPrices, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ParseGasPrices parses the given comma-separated list of gas prices, keeping the order in which they appear
+func ParseGasPrices(gasPrices string) (sdk.DecCoins, error) {
+	var parsed sdk.DecCoins
+	for _, gasPrice := range strings.Split(gasPrices, ",") {
+		if strings.TrimSpace(gasPrice) == "" {
+			continue
+		}
+
+		coin, err := sdk.ParseDecCoin(gasPrice)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, coin)
+	}
+	return parsed, nil
+}
+
+// QueryMinimumGasPrices returns the minimum gas prices that are accepted by the node, based on its configuration
+// and on the parameters of the x/globalfee module, if present. When the chain uses the x/globalfee module, only
+// its fee denoms are returned, each one having the highest price between the global and the node one
+func QueryMinimumGasPrices(grpcConn grpc.ClientConnInterface) (sdk.DecCoins, error) {
+	nodeRes, err := nodeservice.NewServiceClient(grpcConn).Config(context.Background(), &nodeservice.ConfigRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error while querying the node config: %s", err)
+	}
+
+	nodeGasPrices, err := ParseGasPrices(nodeRes.MinimumGasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing the node minimum gas prices: %s", err)
+	}
+
+	// Chains that do not have the x/globalfee module do not implement its query service, so we only rely on the node config
+	globalRes, err := globalfee.NewQueryClient(grpcConn).MinimumGasPrices(context.Background(), &globalfee.QueryMinimumGasPricesRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return nodeGasPrices, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while querying the global fee minimum gas prices: %s", err)
+	}
+
+	if len(globalRes.MinimumGasPrices) == 0 {
+		return nodeGasPrices, nil
+	}
+
+	gasPrices := make(sdk.DecCoins, len(globalRes.MinimumGasPrices))
+	for i, gasPrice := range globalRes.MinimumGasPrices {
+		gasPrices[i] = gasPrice
+		for _, nodeGasPrice := range nodeGasPrices {
+			if nodeGasPrice.Denom == gasPrice.Denom && nodeGasPrice.Amount.GT(gasPrice.Amount) {
+				gasPrices[i].Amount = nodeGasPrice.Amount
+			}
+		}
+	}
+	return gasPrices, nil
+}
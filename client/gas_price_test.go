@@ -0,0 +1,208 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/status"
+
+	"github.com/riccardom/cosmos-go-wallet/client"
+	"github.com/riccardom/cosmos-go-wallet/gprc"
+)
+
+// mockConn represents a gRPC connection that answers to the methods having a handler, using the given codec.
+// Each handler receives the serialized request and returns the serialized response
+type mockConn struct {
+	grpc.ClientConnInterface
+
+	cdc      encoding.Codec
+	handlers map[string]func(req []byte) ([]byte, error)
+}
+
+func (c *mockConn) Invoke(_ context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	handler, ok := c.handlers[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+
+	reqBz, err := c.cdc.Marshal(args)
+	if err != nil {
+		return err
+	}
+
+	resBz, err := handler(reqBz)
+	if err != nil {
+		return err
+	}
+	return c.cdc.Unmarshal(resBz, reply)
+}
+
+// testCodecs contains the codecs used by the gRPC connections that can be created by the client
+var testCodecs = map[string]encoding.Codec{
+	"sdk codec":   codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec(),
+	"proto codec": encoding.GetCodec(proto.Name),
+}
+
+// encodeDecCoins returns the Protobuf encoding of a message having the given coins as its first field
+func encodeDecCoins(t *testing.T, coins ...sdk.DecCoin) []byte {
+	t.Helper()
+
+	var bz []byte
+	for _, coin := range coins {
+		coinBz, err := coin.Marshal()
+		require.NoError(t, err)
+		bz = append(bz, 0x0a, byte(len(coinBz)))
+		bz = append(bz, coinBz...)
+	}
+	return bz
+}
+
+func TestFeeMarketGasPriceProvider(t *testing.T) {
+	gasPrice := sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(25, 4))
+
+	for name, cdc := range testCodecs {
+		t.Run(name, func(t *testing.T) {
+			conn := &mockConn{cdc: cdc, handlers: map[string]func(req []byte) ([]byte, error){
+				"/feemarket.feemarket.v1.Query/GasPrice": func(req []byte) ([]byte, error) {
+					// Make sure the request contains the expected denom
+					expectedReq := append([]byte{0x0a, byte(len(gasPrice.Denom))}, gasPrice.Denom...)
+					if !bytes.Equal(req, expectedReq) {
+						return nil, fmt.Errorf("invalid request: %X", req)
+					}
+					return encodeDecCoins(t, gasPrice), nil
+				},
+			}}
+
+			prices, err := client.NewFeeMarketGasPriceProvider(conn, "stake").GetGasPrices()
+			require.NoError(t, err)
+			require.Equal(t, sdk.DecCoins{gasPrice}, prices)
+
+			_, err = client.NewFeeMarketGasPriceProvider(conn, "uatom").GetGasPrices()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestStaticGasPriceProvider(t *testing.T) {
+	gasPrices := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(25, 4))}
+
+	prices, err := client.NewStaticGasPriceProvider(gasPrices).GetGasPrices()
+	require.NoError(t, err)
+	require.Equal(t, gasPrices, prices)
+}
+
+func TestParseGasPrices(t *testing.T) {
+	gasPrices, err := client.ParseGasPrices("0.025uatom, 0.1stake,")
+	require.NoError(t, err)
+
+	// The order must be kept, since it represents the preference
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyNewDecWithPrec(25, 3)),
+		sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(1, 1)),
+	}, gasPrices)
+
+	_, err = client.ParseGasPrices("0.025")
+	require.Error(t, err)
+}
+
+func TestQueryMinimumGasPrices(t *testing.T) {
+	nodeHandler := func(_ []byte) ([]byte, error) {
+		res := &nodeservice.ConfigResponse{MinimumGasPrice: "0.1stake,0.01uatom"}
+		return res.Marshal()
+	}
+
+	for name, cdc := range testCodecs {
+		t.Run(name, func(t *testing.T) {
+			// Only the node config is available
+			conn := &mockConn{cdc: cdc, handlers: map[string]func(req []byte) ([]byte, error){
+				"/cosmos.base.node.v1beta1.Service/Config": nodeHandler,
+			}}
+
+			gasPrices, err := client.QueryMinimumGasPrices(conn)
+			require.NoError(t, err)
+			require.Equal(t, "0.100000000000000000stake,0.010000000000000000uatom", gasPrices.String())
+
+			// The x/globalfee params are available as well
+			conn.handlers["/gaia.globalfee.v1beta1.Query/MinimumGasPrices"] = func(_ []byte) ([]byte, error) {
+				return encodeDecCoins(t,
+					sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyNewDecWithPrec(5, 3)),
+					sdk.NewDecCoinFromDec("ufoo", sdkmath.LegacyNewDecWithPrec(2, 1)),
+				), nil
+			}
+
+			gasPrices, err = client.QueryMinimumGasPrices(conn)
+			require.NoError(t, err)
+			require.Equal(t, "0.010000000000000000uatom,0.200000000000000000ufoo", gasPrices.String())
+
+			// Errors other than the missing x/globalfee module must be returned
+			conn.handlers["/gaia.globalfee.v1beta1.Query/MinimumGasPrices"] = func(_ []byte) ([]byte, error) {
+				return nil, status.Error(codes.Unavailable, "connection refused")
+			}
+
+			_, err = client.QueryMinimumGasPrices(conn)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestQueryMinimumGasPrices_RPCConnection(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	globalFeeLog := "unknown query path: unknown request"
+	conn := newABCIQueryConn(t, cdc, func(path string) gprc.ABCIQueryResponse {
+		if path == "/cosmos.base.node.v1beta1.Service/Config" {
+			res := &nodeservice.ConfigResponse{MinimumGasPrice: "0.1stake"}
+			bz, err := res.Marshal()
+			require.NoError(t, err)
+			return gprc.ABCIQueryResponse{Value: bz}
+		}
+
+		return gprc.ABCIQueryResponse{
+			Codespace: sdkerrors.ErrUnknownRequest.Codespace(),
+			Code:      sdkerrors.ErrUnknownRequest.ABCICode(),
+			Log:       globalFeeLog,
+		}
+	})
+
+	// Chains without the x/globalfee module answer with an unknown query path error
+	gasPrices, err := client.QueryMinimumGasPrices(conn)
+	require.NoError(t, err)
+	require.Equal(t, "0.100000000000000000stake", gasPrices.String())
+
+	// Any other error must be returned
+	globalFeeLog = "internal error: unknown request"
+	_, err = client.QueryMinimumGasPrices(conn)
+	require.Error(t, err)
+}
+
+func TestClient_GetFees(t *testing.T) {
+	gasPrices := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyNewDecWithPrec(25, 4)),
+		sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(1, 1)),
+	}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	cosmosClient := client.NewClient("cosmos", gasPrices, nil, &mockConn{}, nil, cdc)
+
+	// The preferred gas price should be used, rounding the fees up
+	fees, err := cosmosClient.GetFees(100_001)
+	require.NoError(t, err)
+	require.Equal(t, "251uatom", fees.String())
+	require.Equal(t, "uatom", cosmosClient.GetFeeDenom())
+
+	// The gas prices of the provider should be used once it is set
+	cosmosClient = cosmosClient.WithGasPriceProvider(client.NewStaticGasPriceProvider(gasPrices[1:]))
+	require.Equal(t, "stake", cosmosClient.GetFeeDenom())
+}
@@ -0,0 +1,165 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/riccardom/cosmos-go-wallet/client"
+	"github.com/riccardom/cosmos-go-wallet/gprc"
+	"github.com/riccardom/cosmos-go-wallet/jsonrpc2"
+)
+
+// getTxHandler returns the result of a GetTx query performed by a test connection.
+// When the returned error is not nil, it is returned by the connection as the query error
+type getTxHandler func(call int32) (*sdktx.GetTxResponse, error)
+
+// newMockGRPCConn returns a gRPC connection that answers to the GetTx queries using the given handler
+func newMockGRPCConn(t *testing.T, cdc codec.Codec, handler getTxHandler) grpc.ClientConnInterface {
+	t.Helper()
+
+	var calls int32
+	return &mockConn{cdc: cdc.(*codec.ProtoCodec).GRPCCodec(), handlers: map[string]func(req []byte) ([]byte, error){
+		"/cosmos.tx.v1beta1.Service/GetTx": func(_ []byte) ([]byte, error) {
+			res, err := handler(atomic.AddInt32(&calls, 1))
+			if err != nil {
+				return nil, err
+			}
+			return res.Marshal()
+		},
+	}}
+}
+
+// newMockRPCConn returns a gRPC-over-RPC connection that answers to the GetTx queries using the given handler,
+// converting its errors into ABCI errors as a Cosmos SDK node does
+func newMockRPCConn(t *testing.T, cdc codec.Codec, handler getTxHandler) grpc.ClientConnInterface {
+	t.Helper()
+
+	var calls int32
+	return newABCIQueryConn(t, cdc, func(_ string) gprc.ABCIQueryResponse {
+		var response gprc.ABCIQueryResponse
+		res, err := handler(atomic.AddInt32(&calls, 1))
+		switch {
+		case status.Code(err) == codes.NotFound:
+			response.Codespace = sdkerrors.ErrKeyNotFound.Codespace()
+			response.Code = sdkerrors.ErrKeyNotFound.ABCICode()
+			response.Log = err.Error()
+		case err != nil:
+			response.Codespace = sdkerrors.ErrUnknownRequest.Codespace()
+			response.Code = sdkerrors.ErrUnknownRequest.ABCICode()
+			response.Log = err.Error()
+		default:
+			response.Value, err = res.Marshal()
+			require.NoError(t, err)
+		}
+		return response
+	})
+}
+
+// newABCIQueryConn returns a gRPC-over-RPC connection backed by a node that answers to each ABCI query
+// with the response returned by the given handler, based on the query path
+func newABCIQueryConn(t *testing.T, cdc codec.Codec, handler func(path string) gprc.ABCIQueryResponse) *gprc.Connection {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req jsonrpc2.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "abci_query", req.Method)
+
+		var params gprc.ABCIQueryRequest
+		require.NoError(t, json.Unmarshal(req.Params, &params))
+
+		resultBz, err := json.Marshal(gprc.ABCIQueryResult{Response: handler(params.Path)})
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(jsonrpc2.Response{JSONRPC: jsonrpc2.ProtocolVersion, ID: req.ID, Result: resultBz}))
+	}))
+	t.Cleanup(server.Close)
+
+	conn, err := gprc.NewConnection(server.URL, cdc)
+	require.NoError(t, err)
+	return conn
+}
+
+// testConnections contains the functions used to build the connections supported by the client
+var testConnections = map[string]func(t *testing.T, cdc codec.Codec, handler getTxHandler) grpc.ClientConnInterface{
+	"gRPC connection": newMockGRPCConn,
+	"RPC connection":  newMockRPCConn,
+}
+
+func TestClient_WaitForTx(t *testing.T) {
+	for name, newConn := range testConnections {
+		newConn := newConn
+		t.Run(name, func(t *testing.T) {
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+			// Make sure the query is performed again while the transaction is not found
+			conn := newConn(t, cdc, func(call int32) (*sdktx.GetTxResponse, error) {
+				if call < 3 {
+					return nil, status.Error(codes.NotFound, "tx not found: ABCD")
+				}
+				return &sdktx.GetTxResponse{TxResponse: &sdk.TxResponse{TxHash: "ABCD", Height: 10}}, nil
+			})
+			cosmosClient := client.NewClient("cosmos", nil, nil, conn, nil, cdc).WithTxPollInterval(time.Millisecond)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			res, err := cosmosClient.WaitForTx(ctx, "ABCD")
+			require.NoError(t, err)
+			require.Equal(t, int64(10), res.Height)
+
+			// Make sure other errors are returned right away
+			var calls int32
+			conn = newConn(t, cdc, func(call int32) (*sdktx.GetTxResponse, error) {
+				atomic.StoreInt32(&calls, call)
+				return nil, status.Error(codes.Internal, "internal error")
+			})
+			cosmosClient = client.NewClient("cosmos", nil, nil, conn, nil, cdc).WithTxPollInterval(time.Millisecond)
+
+			_, err = cosmosClient.WaitForTx(ctx, "ABCD")
+			require.Error(t, err)
+			require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+			// Make sure the transaction is not waited for after the context is done
+			conn = newConn(t, cdc, func(_ int32) (*sdktx.GetTxResponse, error) {
+				return nil, status.Error(codes.NotFound, "tx not found: ABCD")
+			})
+			cosmosClient = client.NewClient("cosmos", nil, nil, conn, nil, cdc).WithTxPollInterval(time.Millisecond)
+
+			timeoutCtx, timeoutCancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer timeoutCancel()
+
+			_, err = cosmosClient.WaitForTx(timeoutCtx, "ABCD")
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestClient_WithTxPollInterval(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	conn := newMockGRPCConn(t, cdc, func(_ int32) (*sdktx.GetTxResponse, error) {
+		return &sdktx.GetTxResponse{TxResponse: &sdk.TxResponse{TxHash: "ABCD", Height: 10}}, nil
+	})
+
+	// Invalid intervals should not make the client panic
+	for _, interval := range []time.Duration{0, -time.Second} {
+		cosmosClient := client.NewClient("cosmos", nil, nil, conn, nil, cdc).WithTxPollInterval(interval)
+		res, err := cosmosClient.WaitForTx(context.Background(), "ABCD")
+		require.NoError(t, err)
+		require.Equal(t, int64(10), res.Height)
+	}
+}
@@ -0,0 +1,39 @@
+package ethsecp256k1
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// EthSecp256k1Type is the PubKeyType of the eth_secp256k1 algorithm
+	EthSecp256k1Type = hd.PubKeyType(KeyType)
+)
+
+var (
+	// EthSecp256k1 uses the Bitcoin secp256k1 BIP32 derivation to generate the Ethereum secp256k1 keys
+	// used by Ethermint based chains (e.g. Evmos and Cronos)
+	EthSecp256k1 = ethSecp256k1Algo{}
+)
+
+type ethSecp256k1Algo struct{}
+
+// Name returns eth_secp256k1
+func (s ethSecp256k1Algo) Name() hd.PubKeyType {
+	return EthSecp256k1Type
+}
+
+// Derive derives and returns the eth_secp256k1 private key for the given mnemonic and HD path.
+// The derivation is the same as the one used for standard secp256k1 keys.
+func (s ethSecp256k1Algo) Derive() hd.DeriveFn {
+	return hd.Secp256k1.Derive()
+}
+
+// Generate generates an eth_secp256k1 private key from the given bytes
+func (s ethSecp256k1Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) cryptotypes.PrivKey {
+		bzArr := make([]byte, PrivKeySize)
+		copy(bzArr, bz)
+		return &PrivKey{Key: bzArr}
+	}
+}
@@ -0,0 +1,28 @@
+package ethsecp256k1
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func init() {
+	// Register the keys on the global Amino codec, which is used by the
+	// Cosmos SDK to encrypt and decrypt armored private keys
+	RegisterLegacyAminoCodec(legacy.Cdc)
+}
+
+// RegisterLegacyAminoCodec registers the eth_secp256k1 keys on the given Amino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&PubKey{}, PubKeyName, nil)
+	cdc.RegisterConcrete(&PrivKey{}, PrivKeyName, nil)
+}
+
+// RegisterInterfaces registers the Ethermint eth_secp256k1 keys on the given interface registry.
+// This is required in order to decode the transactions signed using such keys, as well as the keys read from a keyring.
+// It panics if other types have already been registered using the same type URLs (e.g. by an Ethermint codec)
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
+}
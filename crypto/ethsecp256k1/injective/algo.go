@@ -0,0 +1,41 @@
+package injective
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1"
+)
+
+const (
+	// AlgoName is the name used to select the Injective eth_secp256k1 algorithm inside the account configuration.
+	// The algorithm itself is still named eth_secp256k1, as it is inside the Injective keyring
+	AlgoName = "injective_eth_secp256k1"
+)
+
+var (
+	// EthSecp256k1 uses the Bitcoin secp256k1 BIP32 derivation to generate Injective eth_secp256k1 keys
+	EthSecp256k1 = ethSecp256k1Algo{}
+)
+
+type ethSecp256k1Algo struct{}
+
+// Name returns eth_secp256k1
+func (s ethSecp256k1Algo) Name() hd.PubKeyType {
+	return ethsecp256k1.EthSecp256k1Type
+}
+
+// Derive derives and returns the eth_secp256k1 private key for the given mnemonic and HD path.
+// The derivation is the same as the one used for standard secp256k1 keys.
+func (s ethSecp256k1Algo) Derive() hd.DeriveFn {
+	return hd.Secp256k1.Derive()
+}
+
+// Generate generates an eth_secp256k1 private key from the given bytes
+func (s ethSecp256k1Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) cryptotypes.PrivKey {
+		bzArr := make([]byte, ethsecp256k1.PrivKeySize)
+		copy(bzArr, bz)
+		return &PrivKey{Key: bzArr}
+	}
+}
@@ -0,0 +1,150 @@
+package injective
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1"
+)
+
+const (
+	// PrivKeyName is the amino name of the PrivKey type
+	PrivKeyName = "injective/PrivKeyEthSecp256k1"
+
+	// PubKeyName is the amino name of the PubKey type
+	PubKeyName = "injective/PubKeyEthSecp256k1"
+)
+
+var (
+	_ cryptotypes.PrivKey = &PrivKey{}
+	_ cryptotypes.PubKey  = &PubKey{}
+)
+
+// GenPrivKey generates a new random PrivKey
+func GenPrivKey() *PrivKey {
+	return &PrivKey{
+		Key: ethsecp256k1.GenPrivKeyBytes(),
+	}
+}
+
+// Bytes implements cryptotypes.PrivKey
+func (privKey *PrivKey) Bytes() []byte {
+	if privKey == nil {
+		return nil
+	}
+	return privKey.Key
+}
+
+// PubKey implements cryptotypes.PrivKey
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	return &PubKey{
+		Key: ethsecp256k1.PubKeyBytes(privKey.Key),
+	}
+}
+
+// Equals implements cryptotypes.PrivKey
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+// Type implements cryptotypes.PrivKey
+func (privKey *PrivKey) Type() string {
+	return ethsecp256k1.KeyType
+}
+
+// Sign implements cryptotypes.PrivKey
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	return ethsecp256k1.Sign(privKey.Key, msg)
+}
+
+// String implements proto.Message
+func (privKey *PrivKey) String() string {
+	return fmt.Sprintf("PrivKeyEthSecp256k1{%X}", privKey.Key)
+}
+
+// MarshalAmino overrides Amino binary marshaling
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary unmarshaling
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != ethsecp256k1.PrivKeySize {
+		return fmt.Errorf("invalid private key length: expected %d, got %d", ethsecp256k1.PrivKeySize, len(bz))
+	}
+	privKey.Key = bz
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON unmarshaling
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// Address implements cryptotypes.PubKey
+func (pubKey *PubKey) Address() crypto.Address {
+	return ethsecp256k1.Address(pubKey.Key)
+}
+
+// Bytes implements cryptotypes.PubKey
+func (pubKey *PubKey) Bytes() []byte {
+	if pubKey == nil {
+		return nil
+	}
+	return pubKey.Key
+}
+
+// Equals implements cryptotypes.PubKey
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// Type implements cryptotypes.PubKey
+func (pubKey *PubKey) Type() string {
+	return ethsecp256k1.KeyType
+}
+
+// VerifySignature implements cryptotypes.PubKey
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	return ethsecp256k1.VerifySignature(pubKey.Key, msg, sig)
+}
+
+// String implements proto.Message
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey.Key)
+}
+
+// MarshalAmino overrides Amino binary marshaling
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary unmarshaling
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != ethsecp256k1.PubKeySize {
+		return fmt.Errorf("invalid public key length: expected %d, got %d", ethsecp256k1.PubKeySize, len(bz))
+	}
+	pubKey.Key = bz
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON unmarshaling
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
@@ -0,0 +1,240 @@
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// PrivKeySize defines the size of the PrivKey bytes
+	PrivKeySize = 32
+
+	// PubKeySize defines the size of the PubKey bytes, in compressed form
+	PubKeySize = 33
+
+	// SignatureSize defines the size of a signature in the [R || S || V] form
+	SignatureSize = 65
+
+	// KeyType is the string constant for the eth_secp256k1 algorithm
+	KeyType = "eth_secp256k1"
+
+	// PrivKeyName is the amino name of the PrivKey type
+	PrivKeyName = "ethermint/PrivKeyEthSecp256k1"
+
+	// PubKeyName is the amino name of the PubKey type
+	PubKeyName = "ethermint/PubKeyEthSecp256k1"
+)
+
+var (
+	_ cryptotypes.PrivKey = &PrivKey{}
+	_ cryptotypes.PubKey  = &PubKey{}
+)
+
+// Keccak256 returns the Keccak256 hash of the given data
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz)
+	}
+	return hasher.Sum(nil)
+}
+
+// GenPrivKeyBytes generates the bytes of a new random eth_secp256k1 private key
+func GenPrivKeyBytes() []byte {
+	return secp256k1.GenPrivKey().Key
+}
+
+// PubKeyBytes returns the compressed public key associated with the given private key bytes
+func PubKeyBytes(privKey []byte) []byte {
+	return dcrsecp256k1.PrivKeyFromBytes(privKey).PubKey().SerializeCompressed()
+}
+
+// Sign creates a recoverable ECDSA signature of the Keccak256 hash of the given message using the given private key.
+// The returned signature will be of the form R || S || V, with V being either 0 or 1.
+func Sign(privKey []byte, msg []byte) ([]byte, error) {
+	if len(privKey) != PrivKeySize {
+		return nil, fmt.Errorf("invalid private key length: expected %d, got %d", PrivKeySize, len(privKey))
+	}
+
+	priv := dcrsecp256k1.PrivKeyFromBytes(privKey)
+	sig := ecdsa.SignCompact(priv, Keccak256(msg), false)
+
+	// Move the recovery code from the first to the last byte, using the Ethereum format
+	return append(sig[1:], sig[0]-27), nil
+}
+
+// Address returns the address associated with the given compressed public key, which is computed by
+// taking the last 20 bytes of the Keccak256 hash of the uncompressed key.
+// Since public keys can be read from untrusted chain data, nil is returned if the key is invalid
+func Address(pubKey []byte) crypto.Address {
+	pub, err := dcrsecp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return nil
+	}
+
+	// Remove the 0x04 prefix from the uncompressed key before hashing it
+	return Keccak256(pub.SerializeUncompressed()[1:])[12:]
+}
+
+// VerifySignature verifies a signature of the form R || S || V, or R || S, of the Keccak256 hash
+// of the given message using the provided compressed public key
+func VerifySignature(pubKey []byte, msg []byte, sig []byte) bool {
+	if len(sig) == SignatureSize {
+		// Remove the recovery ID
+		sig = sig[:SignatureSize-1]
+	}
+	if len(sig) != SignatureSize-1 {
+		return false
+	}
+
+	pub, err := dcrsecp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	var r, s dcrsecp256k1.ModNScalar
+	r.SetByteSlice(sig[:32])
+	s.SetByteSlice(sig[32:])
+
+	// Reject malleable signatures
+	if s.IsOverHalfOrder() {
+		return false
+	}
+
+	return ecdsa.NewSignature(&r, &s).Verify(Keccak256(msg), pub)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GenPrivKey generates a new random PrivKey
+func GenPrivKey() *PrivKey {
+	return &PrivKey{
+		Key: GenPrivKeyBytes(),
+	}
+}
+
+// Bytes implements cryptotypes.PrivKey
+func (privKey *PrivKey) Bytes() []byte {
+	if privKey == nil {
+		return nil
+	}
+	return privKey.Key
+}
+
+// PubKey implements cryptotypes.PrivKey
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	return &PubKey{
+		Key: PubKeyBytes(privKey.Key),
+	}
+}
+
+// Equals implements cryptotypes.PrivKey
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+// Type implements cryptotypes.PrivKey
+func (privKey *PrivKey) Type() string {
+	return KeyType
+}
+
+// Sign implements cryptotypes.PrivKey
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	return Sign(privKey.Key, msg)
+}
+
+// String implements proto.Message
+func (privKey *PrivKey) String() string {
+	return fmt.Sprintf("PrivKeyEthSecp256k1{%X}", privKey.Key)
+}
+
+// MarshalAmino overrides Amino binary marshaling
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary unmarshaling
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid private key length: expected %d, got %d", PrivKeySize, len(bz))
+	}
+	privKey.Key = bz
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON unmarshaling
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// Address implements cryptotypes.PubKey
+func (pubKey *PubKey) Address() crypto.Address {
+	return Address(pubKey.Key)
+}
+
+// Bytes implements cryptotypes.PubKey
+func (pubKey *PubKey) Bytes() []byte {
+	if pubKey == nil {
+		return nil
+	}
+	return pubKey.Key
+}
+
+// Equals implements cryptotypes.PubKey
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// Type implements cryptotypes.PubKey
+func (pubKey *PubKey) Type() string {
+	return KeyType
+}
+
+// VerifySignature implements cryptotypes.PubKey
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	return VerifySignature(pubKey.Key, msg, sig)
+}
+
+// String implements proto.Message
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey.Key)
+}
+
+// MarshalAmino overrides Amino binary marshaling
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary unmarshaling
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return fmt.Errorf("invalid public key length: expected %d, got %d", PubKeySize, len(bz))
+	}
+	pubKey.Key = bz
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON unmarshaling
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
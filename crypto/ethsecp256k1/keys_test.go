@@ -0,0 +1,85 @@
+package ethsecp256k1_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1"
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1/injective"
+)
+
+func TestPrivKey_PubKey(t *testing.T) {
+	// Test vector taken from the EIP-155 specification
+	keyBz, err := hex.DecodeString(strings.Repeat("46", 32))
+	require.NoError(t, err)
+
+	privKey := &ethsecp256k1.PrivKey{Key: keyBz}
+	address := privKey.PubKey().Address()
+	require.Equal(t, "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f", strings.ToLower(address.String()))
+}
+
+func TestPubKey_Address_InvalidKey(t *testing.T) {
+	// Malformed keys read from the chain should not make the caller panic
+	for _, pubKey := range []cryptotypes.PubKey{
+		&ethsecp256k1.PubKey{Key: []byte{0x01, 0x02, 0x03}},
+		&injective.PubKey{Key: make([]byte, ethsecp256k1.PubKeySize)},
+	} {
+		require.Nil(t, pubKey.Address())
+	}
+}
+
+func TestPrivKey_Sign(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := []byte("message to be signed")
+	signature, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, signature, ethsecp256k1.SignatureSize)
+	require.True(t, pubKey.VerifySignature(msg, signature))
+	require.True(t, pubKey.VerifySignature(msg, signature[:ethsecp256k1.SignatureSize-1]))
+	require.False(t, pubKey.VerifySignature([]byte("another message"), signature))
+	require.False(t, ethsecp256k1.GenPrivKey().PubKey().VerifySignature(msg, signature))
+}
+
+func TestPubKey_Any(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pubKey  cryptotypes.PubKey
+		typeURL string
+	}{
+		{
+			name:    "Ethermint public key",
+			pubKey:  ethsecp256k1.GenPrivKey().PubKey(),
+			typeURL: "/ethermint.crypto.v1.ethsecp256k1.PubKey",
+		},
+		{
+			name:    "Injective public key",
+			pubKey:  injective.GenPrivKey().PubKey(),
+			typeURL: "/injective.crypto.v1beta1.ethsecp256k1.PubKey",
+		},
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	ethsecp256k1.RegisterInterfaces(registry)
+	injective.RegisterInterfaces(registry)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			anyPubKey, err := codectypes.NewAnyWithValue(tc.pubKey)
+			require.NoError(t, err)
+			require.Equal(t, tc.typeURL, anyPubKey.TypeUrl)
+
+			var decoded cryptotypes.PubKey
+			require.NoError(t, registry.UnpackAny(&codectypes.Any{TypeUrl: anyPubKey.TypeUrl, Value: anyPubKey.Value}, &decoded))
+			require.True(t, tc.pubKey.Equals(decoded))
+			require.Equal(t, tc.pubKey.Address(), decoded.Address())
+		})
+	}
+}
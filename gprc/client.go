@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -71,7 +73,7 @@ func (c *Connection) Invoke(ctx context.Context, method string, args, reply any,
 	}
 
 	if !res.Response.IsOK() {
-		return status.Error(codes.Unknown, res.Response.Log) // TODO: better status code?
+		return statusFromResponse(res.Response)
 	}
 
 	err = c.gprcCdc.Unmarshal(res.Response.Value, reply)
@@ -82,6 +84,29 @@ func (c *Connection) Invoke(ctx context.Context, method string, args, reply any,
 	return nil
 }
 
+// statusFromResponse returns the gRPC status error associated with the error contained inside the given response,
+// reverting the conversion that the Cosmos SDK performs when answering to gRPC queries through ABCI
+func statusFromResponse(res ABCIQueryResponse) error {
+	code := codes.Unknown
+	if res.Codespace == sdkerrors.RootCodespace {
+		switch res.Code {
+		case sdkerrors.ErrKeyNotFound.ABCICode():
+			code = codes.NotFound
+		case sdkerrors.ErrInvalidRequest.ABCICode():
+			code = codes.InvalidArgument
+		case sdkerrors.ErrUnauthorized.ABCICode():
+			code = codes.Unauthenticated
+		case sdkerrors.ErrUnknownRequest.ABCICode():
+			// Queries to a service that is not registered on the chain fail with an unknown query path error
+			if strings.Contains(res.Log, "unknown query path") {
+				code = codes.Unimplemented
+			}
+		}
+	}
+
+	return status.Error(code, res.Log)
+}
+
 // RunABCIQuery runs a new query through the ABCI protocol
 func (c *Connection) RunABCIQuery(ctx context.Context, path string, data []byte, height int64) (*ABCIQueryResult, error) {
 	var res ABCIQueryResult
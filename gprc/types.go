@@ -76,11 +76,12 @@ type ABCIQueryRequest struct {
 }
 
 type ABCIQueryResponse struct {
-	Code   uint32 `json:"code"`
-	Log    string `json:"log"`
-	Key    []byte `json:"key"`
-	Value  []byte `json:"value"`
-	Height int64  `json:"height,string"`
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace"`
+	Log       string `json:"log"`
+	Key       []byte `json:"key"`
+	Value     []byte `json:"value"`
+	Height    int64  `json:"height,string"`
 }
 
 func (resp ABCIQueryResponse) IsOK() bool {
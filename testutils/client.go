@@ -0,0 +1,338 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	comettypes "github.com/cometbft/cometbft/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MockClient represents a client that can be used during tests without the need of a running chain
+type MockClient struct {
+	mu sync.Mutex
+
+	TxConfig  sdkclient.TxConfig
+	Prefix    string
+	ChainID   string
+	GasPrices sdk.DecCoins
+
+	// AccountNumber is the account number returned for all the accounts
+	AccountNumber uint64
+
+	// Sequences contains the on-chain sequence of each account
+	Sequences map[string]uint64
+
+	// Balances contains the balances of each account
+	Balances map[string]sdk.Coins
+
+	// DefaultBalances contains the balances of the accounts that are not inside Balances
+	DefaultBalances sdk.Coins
+
+	// LockedBalances contains the part of the balances of each account that cannot be spent (e.g. vesting tokens)
+	LockedBalances map[string]sdk.Coins
+
+	// FeeAllowanceFn, if set, is used to get the fee allowance given by a granter to a grantee.
+	// When nil, no fee allowance exists
+	FeeAllowanceFn func(granter string, grantee string) (feegrant.FeeAllowanceI, error)
+
+	// SimulatedGas is the amount of gas returned when simulating a transaction
+	SimulatedGas uint64
+
+	// Offline tells whether all the methods requiring a network access should return an error
+	Offline bool
+
+	// CheckSequences tells whether the sequences of the broadcasted transactions should be checked
+	// against the ones of the pending state, similarly to what the chain does during CheckTx
+	CheckSequences bool
+
+	// pendingSequences contains the sequence of each account after including the pending transactions
+	pendingSequences map[string]uint64
+
+	// Height is the height of the latest committed block
+	Height int64
+
+	// pendingTxs contains the hashes of the transactions that have been broadcasted but not committed yet
+	pendingTxs []string
+
+	// includedTxs contains the heights at which each committed transaction has been included
+	includedTxs map[string]int64
+
+	// BroadcastFn, if set, is used to broadcast the transactions
+	BroadcastFn func(tx signing.Tx) (*sdk.TxResponse, error)
+
+	// BroadcastedTxs contains all the transactions that have been broadcasted
+	BroadcastedTxs []signing.Tx
+}
+
+// NewMockClient returns a new MockClient instance
+func NewMockClient(txConfig sdkclient.TxConfig, prefix string) *MockClient {
+	return &MockClient{
+		TxConfig:        txConfig,
+		Prefix:          prefix,
+		ChainID:         "testchain",
+		GasPrices:       sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(1, 2))},
+		AccountNumber:   1,
+		Sequences:       map[string]uint64{},
+		Balances:        map[string]sdk.Coins{},
+		DefaultBalances: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000_000))),
+		LockedBalances:  map[string]sdk.Coins{},
+		SimulatedGas:    100_000,
+
+		pendingSequences: map[string]uint64{},
+		includedTxs:      map[string]int64{},
+	}
+}
+
+// Commit includes all the pending transactions inside a new block, updating the on-chain sequences accordingly
+func (c *MockClient) Commit() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for address, sequence := range c.pendingSequences {
+		c.Sequences[address] = sequence
+	}
+	c.pendingSequences = map[string]uint64{}
+
+	c.Height++
+	for _, hash := range c.pendingTxs {
+		c.includedTxs[hash] = c.Height
+	}
+	c.pendingTxs = nil
+}
+
+// GetTxConfig implements wallet.Client
+func (c *MockClient) GetTxConfig() sdkclient.TxConfig {
+	return c.TxConfig
+}
+
+// GetAccountPrefix implements wallet.Client
+func (c *MockClient) GetAccountPrefix() string {
+	return c.Prefix
+}
+
+// errOffline is returned by the methods requiring a network access when the client is offline
+var errOffline = fmt.Errorf("client is offline")
+
+// GetChainID implements wallet.Client
+func (c *MockClient) GetChainID() (string, error) {
+	if c.Offline {
+		return "", errOffline
+	}
+	return c.ChainID, nil
+}
+
+// GetLatestHeight implements wallet.Client
+func (c *MockClient) GetLatestHeight() (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Offline {
+		return 0, errOffline
+	}
+	return c.Height, nil
+}
+
+// GetAccount implements wallet.Client
+func (c *MockClient) GetAccount(address string) (sdk.AccountI, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Offline {
+		return nil, errOffline
+	}
+
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return authtypes.NewBaseAccount(bz, nil, c.AccountNumber, c.Sequences[address]), nil
+}
+
+// GetBalances implements wallet.Client
+func (c *MockClient) GetBalances(address string) (sdk.Coins, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Offline {
+		return nil, errOffline
+	}
+	balances, ok := c.Balances[address]
+	if !ok {
+		return c.DefaultBalances, nil
+	}
+	return balances, nil
+}
+
+// GetSpendableBalances implements wallet.Client
+func (c *MockClient) GetSpendableBalances(address string) (sdk.Coins, error) {
+	balances, err := c.GetBalances(address)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	spendable := sdk.NewCoins()
+	for _, coin := range balances {
+		// Locked tokens exceeding the balance do not make it negative
+		locked := c.LockedBalances[address].AmountOf(coin.Denom)
+		if coin.Amount.GT(locked) {
+			spendable = spendable.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(locked)))
+		}
+	}
+	return spendable, nil
+}
+
+// GetFeeAllowance implements wallet.Client
+func (c *MockClient) GetFeeAllowance(granter string, grantee string) (feegrant.FeeAllowanceI, error) {
+	if c.Offline {
+		return nil, errOffline
+	}
+
+	if c.FeeAllowanceFn == nil {
+		return nil, fmt.Errorf("fee-grant not found: granter %s, grantee %s", granter, grantee)
+	}
+	return c.FeeAllowanceFn(granter, grantee)
+}
+
+// GetGasPrices implements wallet.Client
+func (c *MockClient) GetGasPrices() (sdk.DecCoins, error) {
+	if c.Offline {
+		return nil, errOffline
+	}
+	return c.GasPrices, nil
+}
+
+// SimulateTx implements wallet.Client
+func (c *MockClient) SimulateTx(_ signing.Tx) (uint64, error) {
+	if c.Offline {
+		return 0, errOffline
+	}
+	return c.SimulatedGas, nil
+}
+
+// checkSequences checks the sequences of the given transaction signers against the ones of the pending state,
+// returning a response containing the ErrWrongSequence error if they do not match.
+// If all the sequences are valid, the pending sequences are incremented and nil is returned instead
+func (c *MockClient) checkSequences(tx signing.Tx) (*sdk.TxResponse, error) {
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, len(sigs))
+	for i, sig := range sigs {
+		addresses[i], err = bech32.ConvertAndEncode(c.Prefix, sig.PubKey.Address())
+		if err != nil {
+			return nil, err
+		}
+
+		expected, ok := c.pendingSequences[addresses[i]]
+		if !ok {
+			expected = c.Sequences[addresses[i]]
+		}
+
+		if sig.Sequence != expected {
+			return &sdk.TxResponse{
+				Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+				Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+				RawLog: fmt.Sprintf("account sequence mismatch, expected %d, got %d: %s",
+					expected, sig.Sequence, sdkerrors.ErrWrongSequence.Error()),
+			}, nil
+		}
+	}
+
+	for i, sig := range sigs {
+		c.pendingSequences[addresses[i]] = sig.Sequence + 1
+	}
+
+	return nil, nil
+}
+
+// broadcast stores the given transaction and returns the response of BroadcastFn, if set
+func (c *MockClient) broadcast(tx signing.Tx) (*sdk.TxResponse, error) {
+	c.mu.Lock()
+	if c.CheckSequences {
+		res, err := c.checkSequences(tx)
+		if res != nil || err != nil {
+			c.mu.Unlock()
+			return res, err
+		}
+	}
+	c.BroadcastedTxs = append(c.BroadcastedTxs, tx)
+	broadcastFn := c.BroadcastFn
+	c.mu.Unlock()
+
+	res, err := c.getBroadcastResponse(tx, broadcastFn)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code == 0 {
+		c.mu.Lock()
+		c.pendingTxs = append(c.pendingTxs, res.TxHash)
+		c.mu.Unlock()
+	}
+
+	return res, nil
+}
+
+// getBroadcastResponse returns the response of the given broadcast function, if not nil.
+// Otherwise, it returns a successful response containing the hash of the transaction
+func (c *MockClient) getBroadcastResponse(tx signing.Tx, broadcastFn func(tx signing.Tx) (*sdk.TxResponse, error)) (*sdk.TxResponse, error) {
+	if broadcastFn != nil {
+		return broadcastFn(tx)
+	}
+
+	bz, err := c.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.TxResponse{TxHash: fmt.Sprintf("%X", comettypes.Tx(bz).Hash())}, nil
+}
+
+// WaitForTx implements wallet.Client
+func (c *MockClient) WaitForTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	for {
+		c.mu.Lock()
+		height, ok := c.includedTxs[hash]
+		c.mu.Unlock()
+
+		if ok {
+			return &sdk.TxResponse{TxHash: hash, Height: height}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("error while waiting for transaction %s: %s", hash, ctx.Err())
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
+
+// BroadcastTxAsync implements wallet.Client
+func (c *MockClient) BroadcastTxAsync(tx signing.Tx) (*sdk.TxResponse, error) {
+	return c.broadcast(tx)
+}
+
+// BroadcastTxSync implements wallet.Client
+func (c *MockClient) BroadcastTxSync(tx signing.Tx) (*sdk.TxResponse, error) {
+	return c.broadcast(tx)
+}
+
+// BroadcastTxCommit implements wallet.Client
+func (c *MockClient) BroadcastTxCommit(tx signing.Tx) (*sdk.TxResponse, error) {
+	return c.broadcast(tx)
+}
@@ -7,11 +7,14 @@ import (
 	"cosmossdk.io/x/evidence"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
 	nftmodule "cosmossdk.io/x/nft/module"
+	"cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -32,6 +35,10 @@ import (
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1"
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1/injective"
 )
 
 type EncodingConfig struct {
@@ -41,9 +48,15 @@ type EncodingConfig struct {
 	Amino             *codec.LegacyAmino
 }
 
-// MakeTestEncodingConfig returns the encoding config to be used for the tests.
+// MakeTestEncodingConfig returns the encoding config to be used for the tests, using the global Bech32 prefix.
 // Note: This is copied from the simapp package so that we can avoid having a dependency on it.
 func MakeTestEncodingConfig() EncodingConfig {
+	return MakeTestEncodingConfigWithPrefix(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+// MakeTestEncodingConfigWithPrefix returns the encoding config to be used for the tests of a chain
+// having the given Bech32 account prefix
+func MakeTestEncodingConfigWithPrefix(bech32Prefix string) EncodingConfig {
 	moduleBasics := module.NewBasicManager(
 		auth.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
@@ -72,7 +85,16 @@ func MakeTestEncodingConfig() EncodingConfig {
 	)
 
 	amino := codec.NewLegacyAmino()
-	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          address.NewBech32Codec(bech32Prefix),
+			ValidatorAddressCodec: address.NewBech32Codec(bech32Prefix + sdk.PrefixValidator + sdk.PrefixOperator),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
 	cdc := codec.NewProtoCodec(interfaceRegistry)
 
 	encodingConfig := EncodingConfig{
@@ -86,6 +108,8 @@ func MakeTestEncodingConfig() EncodingConfig {
 	std.RegisterInterfaces(encodingConfig.InterfaceRegistry)
 	moduleBasics.RegisterLegacyAminoCodec(encodingConfig.Amino)
 	moduleBasics.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	ethsecp256k1.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	injective.RegisterInterfaces(encodingConfig.InterfaceRegistry)
 
 	return encodingConfig
 }
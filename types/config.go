@@ -1,14 +1,67 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+)
+
 type ChainConfig struct {
-	Bech32Prefix  string  `toml:"bech32_prefix" yaml:"bech32_prefix"`
-	RPCAddr       string  `toml:"rpc_addr" yaml:"rpc_addr"`
-	GRPCAddr      string  `toml:"grpc_addr" yaml:"grpc_addr"`
+	Bech32Prefix string `toml:"bech32_prefix" yaml:"bech32_prefix"`
+	RPCAddr      string `toml:"rpc_addr" yaml:"rpc_addr"`
+	GRPCAddr     string `toml:"grpc_addr" yaml:"grpc_addr"`
+	// GasPrice contains the comma-separated gas prices to be used, sorted by preference (e.g. 0.01uatom,0.02ufoo).
+	// If empty, the minimum gas prices accepted by the node are used instead
 	GasPrice      string  `toml:"gas_price" yaml:"gas_price"`
 	GasAdjustment float64 `toml:"gas_adjustment" yaml:"gas_adjustment"`
+
+	// UseFeeMarket tells whether the gas prices should be read from the x/feemarket module before computing the
+	// fees of each transaction. When enabled, the gas prices denoms are used to query the current gas prices
+	UseFeeMarket bool `toml:"use_fee_market" yaml:"use_fee_market"`
+
+	// EnableSignModeTextual tells whether SIGN_MODE_TEXTUAL should be enabled, querying
+	// the coins metadata using the gRPC connection
+	EnableSignModeTextual bool `toml:"enable_sign_mode_textual" yaml:"enable_sign_mode_textual"`
 }
 
 type AccountConfig struct {
 	Mnemonic string `toml:"mnemonic" yaml:"mnemonic"`
 	HDPath   string `toml:"hd_path" yaml:"hd_path"`
+
+	// Passphrase is the optional BIP39 passphrase (also known as 25th word) used to derive the key
+	Passphrase string `toml:"passphrase" yaml:"passphrase"`
+
+	// Algo is the signing algorithm used to derive the key. It can be either secp256k1, eth_secp256k1 for
+	// Ethermint based chains (e.g. Evmos and Cronos) or injective_eth_secp256k1 for Injective. If empty,
+	// secp256k1 is used
+	Algo string `toml:"algo" yaml:"algo"`
+}
+
+// WithAddressIndex returns a copy of this configuration having the address index
+// of the HD path replaced with the given one
+func (c AccountConfig) WithAddressIndex(index uint32) (*AccountConfig, error) {
+	params, err := hd.NewParamsFromPath(c.HDPath)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing HD path: %s", err)
+	}
+
+	params.AddressIndex = index
+	c.HDPath = params.String()
+	return &c, nil
+}
+
+// KeyringConfig contains the data used to read a key from a Cosmos SDK keyring
+type KeyringConfig struct {
+	// AppName is the name of the application that owns the keyring (e.g. simd)
+	AppName string `toml:"app_name" yaml:"app_name"`
+
+	// Backend is the keyring backend to be used (e.g. file, test or memory)
+	Backend string `toml:"backend" yaml:"backend"`
+
+	// Dir is the home directory of the application. The keyring files
+	// will be read from the keyring-<backend> folder inside it
+	Dir string `toml:"dir" yaml:"dir"`
+
+	// KeyName is the name of the key to be used
+	KeyName string `toml:"key_name" yaml:"key_name"`
 }
@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+func TestAccountConfig_WithAddressIndex(t *testing.T) {
+	cfg := types.AccountConfig{
+		Mnemonic:   "mnemonic",
+		HDPath:     "m/44'/852'/0'/0/0",
+		Passphrase: "passphrase",
+	}
+
+	indexCfg, err := cfg.WithAddressIndex(5)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/852'/0'/0/5", indexCfg.HDPath)
+	require.Equal(t, cfg.Mnemonic, indexCfg.Mnemonic)
+	require.Equal(t, cfg.Passphrase, indexCfg.Passphrase)
+
+	// Make sure the original config is not changed
+	require.Equal(t, "m/44'/852'/0'/0/0", cfg.HDPath)
+
+	_, err = types.AccountConfig{HDPath: "invalid"}.WithAddressIndex(1)
+	require.Error(t, err)
+}
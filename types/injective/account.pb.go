@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: injective/types/v1beta1/account.proto
+
+package injective
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EthAccount defines the account type used by Injective.
+// It embeds an authtypes.BaseAccount, so that it implements the authtypes.AccountI interface.
+type EthAccount struct {
+	// base_account is an authtypes.BaseAccount
+	*types.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty" yaml:"base_account"`
+	// code_hash is the hash calculated from the code contents
+	CodeHash []byte `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty" yaml:"code_hash"`
+}
+
+func (m *EthAccount) Reset()      { *m = EthAccount{} }
+func (*EthAccount) ProtoMessage() {}
+func (*EthAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e25f61138fdc8ede, []int{0}
+}
+func (m *EthAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthAccount.Merge(m, src)
+}
+func (m *EthAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthAccount proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*EthAccount)(nil), "injective.types.v1beta1.EthAccount")
+}
+
+func init() {
+	proto.RegisterFile("injective/types/v1beta1/account.proto", fileDescriptor_e25f61138fdc8ede)
+}
+
+var fileDescriptor_e25f61138fdc8ede = []byte{
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0xbd, 0x4e, 0x02, 0x31,
+	0x1c, 0x6f, 0x1d, 0x8c, 0x1e, 0x0c, 0x06, 0x49, 0x44, 0x4c, 0x5a, 0x72, 0x89, 0x09, 0xcb, 0xb5,
+	0x01, 0xe3, 0xc2, 0xe6, 0x25, 0x26, 0x3a, 0x99, 0x30, 0xba, 0x60, 0xaf, 0x34, 0xd7, 0x53, 0xa0,
+	0x84, 0xf6, 0x50, 0xde, 0xc0, 0xd1, 0xd1, 0x91, 0x87, 0xf0, 0x21, 0x8c, 0x13, 0xa3, 0x13, 0x31,
+	0x5c, 0x4c, 0x9c, 0x79, 0x02, 0xc3, 0xb5, 0x39, 0x98, 0xee, 0xff, 0xf1, 0xfb, 0xb8, 0xfe, 0xfe,
+	0xde, 0x79, 0x32, 0x7a, 0x14, 0xdc, 0x24, 0x53, 0x41, 0xcd, 0x6c, 0x2c, 0x34, 0x9d, 0xb6, 0x22,
+	0x61, 0x58, 0x8b, 0x32, 0xce, 0x55, 0x3a, 0x32, 0x64, 0x3c, 0x51, 0x46, 0x55, 0x4e, 0x0a, 0x18,
+	0xc9, 0x61, 0xc4, 0xc1, 0xea, 0x88, 0x2b, 0x3d, 0x54, 0x9a, 0xb2, 0xd4, 0xc8, 0x2d, 0x37, 0x35,
+	0xd2, 0x12, 0xeb, 0xa7, 0x76, 0xdf, 0xcb, 0x3b, 0x6a, 0x1b, 0xb7, 0xaa, 0xc6, 0x2a, 0x56, 0x76,
+	0xbe, 0xa9, 0xec, 0xd4, 0xff, 0x85, 0x9e, 0x77, 0x6d, 0xe4, 0x95, 0xb5, 0xaf, 0x3c, 0x78, 0xe5,
+	0x88, 0x69, 0xd1, 0x73, 0xbf, 0x53, 0x83, 0x0d, 0xd8, 0x2c, 0xb5, 0x1b, 0xc4, 0x29, 0xe5, 0x4e,
+	0xce, 0x96, 0x84, 0x4c, 0x0b, 0xc7, 0x0b, 0xcf, 0x16, 0x4b, 0x0c, 0xd7, 0x4b, 0x7c, 0x3c, 0x63,
+	0xc3, 0x41, 0xc7, 0xdf, 0xd5, 0xf0, 0xbb, 0xa5, 0x68, 0x8b, 0xac, 0xb4, 0xbc, 0x43, 0xae, 0xfa,
+	0xa2, 0x27, 0x99, 0x96, 0xb5, 0xbd, 0x06, 0x6c, 0x96, 0xc3, 0xea, 0x7a, 0x89, 0x8f, 0x2c, 0xb1,
+	0x58, 0xf9, 0xdd, 0x83, 0x4d, 0x7d, 0xc3, 0xb4, 0xec, 0x84, 0xaf, 0x73, 0x0c, 0xde, 0xe7, 0x18,
+	0xfc, 0xcd, 0x31, 0xf8, 0xfa, 0x08, 0xda, 0x71, 0x62, 0x64, 0x1a, 0x11, 0xae, 0x86, 0xee, 0x89,
+	0xee, 0x13, 0xe8, 0xfe, 0x13, 0x7d, 0xb1, 0xe1, 0xd8, 0xdc, 0x9c, 0xeb, 0x6d, 0x78, 0xf7, 0xb9,
+	0x42, 0x70, 0xb1, 0x42, 0xf0, 0x67, 0x85, 0xe0, 0x5b, 0x86, 0xc0, 0x22, 0x43, 0xe0, 0x3b, 0x43,
+	0xe0, 0xfe, 0x72, 0x47, 0x6d, 0x92, 0x70, 0xce, 0x26, 0xfd, 0x42, 0x37, 0x88, 0x55, 0xf0, 0xcc,
+	0x06, 0x03, 0x61, 0xdc, 0xb9, 0x8a, 0xbb, 0x44, 0xfb, 0x79, 0x7e, 0x17, 0xff, 0x03, 0x00, 0x71,
+	0xc0, 0x92, 0xd0, 0xd2, 0x01, 0x00, 0x00,
+}
+
+func (m *EthAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EthAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func sovAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccount(x uint64) (n int) {
+	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EthAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccount = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,17 @@
+package injective
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.AccountI = &EthAccount{}
+)
+
+// RegisterInterfaces registers the Injective account type on the given interface registry.
+// This is required in order to decode the accounts read from the chain.
+// It panics if another type has already been registered using the same type URL (e.g. by an Injective codec)
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.AccountI)(nil), &EthAccount{})
+}
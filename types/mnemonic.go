@@ -0,0 +1,250 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	LanguageEnglish            = "english"
+	LanguageChineseSimplified  = "chinese_simplified"
+	LanguageChineseTraditional = "chinese_traditional"
+	LanguageCzech              = "czech"
+	LanguageFrench             = "french"
+	LanguageItalian            = "italian"
+	LanguageJapanese           = "japanese"
+	LanguageKorean             = "korean"
+	LanguageSpanish            = "spanish"
+
+	// DefaultEntropySize is the entropy size used when generating a 24 words mnemonic
+	DefaultEntropySize = 256
+)
+
+var (
+	// languages contains the supported languages, sorted so that English is always tried first
+	languages = []string{
+		LanguageEnglish,
+		LanguageChineseSimplified,
+		LanguageChineseTraditional,
+		LanguageCzech,
+		LanguageFrench,
+		LanguageItalian,
+		LanguageJapanese,
+		LanguageKorean,
+		LanguageSpanish,
+	}
+
+	wordLists = map[string][]string{
+		LanguageEnglish:            wordlists.English,
+		LanguageChineseSimplified:  wordlists.ChineseSimplified,
+		LanguageChineseTraditional: wordlists.ChineseTraditional,
+		LanguageCzech:              wordlists.Czech,
+		LanguageFrench:             wordlists.French,
+		LanguageItalian:            wordlists.Italian,
+		LanguageJapanese:           wordlists.Japanese,
+		LanguageKorean:             wordlists.Korean,
+		LanguageSpanish:            wordlists.Spanish,
+	}
+
+	// wordIndexes contains, for each language, the index of each NFKD normalized word
+	wordIndexes = map[string]map[string]int{}
+)
+
+func init() {
+	for language, words := range wordLists {
+		indexes := make(map[string]int, len(words))
+		for i, word := range words {
+			indexes[norm.NFKD.String(word)] = i
+		}
+		wordIndexes[language] = indexes
+	}
+}
+
+// MnemonicConfig contains the configuration used to generate a new mnemonic
+type MnemonicConfig struct {
+	// EntropySize is the number of entropy bits, which must be a multiple of 32 between 128 and 256.
+	// If zero, DefaultEntropySize is used
+	EntropySize int `toml:"entropy_size" yaml:"entropy_size"`
+
+	// Language is the language of the wordlist to be used. If empty, English is used
+	Language string `toml:"language" yaml:"language"`
+}
+
+// NewMnemonic generates a new random BIP39 mnemonic based on the given configuration
+func NewMnemonic(config MnemonicConfig) (string, error) {
+	entropySize := config.EntropySize
+	if entropySize == 0 {
+		entropySize = DefaultEntropySize
+	}
+
+	entropy, err := bip39.NewEntropy(entropySize)
+	if err != nil {
+		return "", fmt.Errorf("error while generating entropy: %s", err)
+	}
+
+	return NewMnemonicFromEntropy(entropy, config.Language)
+}
+
+// NewMnemonicFromEntropy returns the BIP39 mnemonic that encodes the given entropy using the words of the
+// provided language. If the language is empty, English is used
+func NewMnemonicFromEntropy(entropy []byte, language string) (string, error) {
+	if language == "" {
+		language = LanguageEnglish
+	}
+
+	words, ok := wordLists[language]
+	if !ok {
+		return "", fmt.Errorf("unsupported mnemonic language: %s", language)
+	}
+
+	// All the word lists contain the same number of words, so the mnemonic is built in English
+	// and then each word is replaced with the one having the same index inside the other word list
+	englishMnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", fmt.Errorf("error while creating mnemonic: %s", err)
+	}
+
+	englishWords := strings.Fields(englishMnemonic)
+	mnemonicWords := make([]string, len(englishWords))
+	for i, word := range englishWords {
+		// Some word lists are stored NFKD normalized, while the official ones use the NFC form
+		mnemonicWords[i] = norm.NFC.String(words[wordIndexes[LanguageEnglish][word]])
+	}
+
+	// As per the BIP39 specification, Japanese mnemonics must be joined using an ideographic space
+	separator := " "
+	if language == LanguageJapanese {
+		separator = "\u3000"
+	}
+
+	return strings.Join(mnemonicWords, separator), nil
+}
+
+// ValidateMnemonic checks whether the given mnemonic is a valid BIP39 mnemonic in any of the supported languages
+func ValidateMnemonic(mnemonic string) error {
+	_, err := getMnemonicLanguage(mnemonic)
+	return err
+}
+
+// getMnemonicLanguage returns the language of the given mnemonic, making sure that its checksum is valid
+func getMnemonicLanguage(mnemonic string) (string, error) {
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return "", fmt.Errorf("invalid mnemonic words count: %d", len(words))
+	}
+
+	for _, language := range languages {
+		englishMnemonic, ok := toEnglishMnemonic(words, wordIndexes[language])
+		if !ok {
+			continue
+		}
+
+		_, err := bip39.MnemonicToByteArray(englishMnemonic)
+		if err == nil {
+			return language, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid mnemonic: unknown words or wrong checksum")
+}
+
+// toEnglishMnemonic returns the English mnemonic having the same words indexes of the given words, which are
+// searched inside the word list having the provided indexes. It returns false if any of the words is not found
+func toEnglishMnemonic(words []string, indexes map[string]int) (string, bool) {
+	englishWords := make([]string, len(words))
+	for i, word := range words {
+		index, ok := indexes[word]
+		if !ok {
+			return "", false
+		}
+		englishWords[i] = wordLists[LanguageEnglish][index]
+	}
+	return strings.Join(englishWords, " "), true
+}
+
+// NewSeed validates the given mnemonic and returns the BIP39 seed associated with it and the given passphrase
+func NewSeed(mnemonic string, passphrase string) ([]byte, error) {
+	err := ValidateMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	// As per the BIP39 specification, both the mnemonic and the passphrase must be NFKD normalized
+	return bip39.NewSeed(norm.NFKD.String(mnemonic), norm.NFKD.String(passphrase)), nil
+}
+
+// DerivePrivKey derives the private key associated with the given account configuration.
+// English mnemonics are derived by the Cosmos SDK. Since it does not support other languages, the keys
+// of the other mnemonics are derived from their BIP39 seed using the same secp256k1 BIP32 derivation
+func DerivePrivKey(accountCfg *AccountConfig) (cryptotypes.PrivKey, error) {
+	algo, err := GetSignatureAlgo(accountCfg.Algo)
+	if err != nil {
+		return nil, err
+	}
+
+	language, err := getMnemonicLanguage(accountCfg.Mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	if language == LanguageEnglish {
+		derivedPriv, err := algo.Derive()(accountCfg.Mnemonic, accountCfg.Passphrase, accountCfg.HDPath)
+		if err != nil {
+			return nil, err
+		}
+		return algo.Generate()(derivedPriv), nil
+	}
+
+	seed, err := NewSeed(accountCfg.Mnemonic, accountCfg.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	masterPriv, chainCode := hd.ComputeMastersFromSeed(seed)
+	if len(accountCfg.HDPath) == 0 {
+		return algo.Generate()(masterPriv[:]), nil
+	}
+
+	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, chainCode, accountCfg.HDPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return algo.Generate()(derivedPriv), nil
+}
+
+// GenerateAccount generates a new account using a fresh mnemonic built based on the given configuration.
+// It returns the configuration that can be used to build a Wallet for the account along with its Bech32 address
+func GenerateAccount(bech32Prefix string, hdPath string, algo string, mnemonicCfg MnemonicConfig) (*AccountConfig, string, error) {
+	mnemonic, err := NewMnemonic(mnemonicCfg)
+	if err != nil {
+		return nil, "", err
+	}
+
+	accountCfg := &AccountConfig{
+		Mnemonic: mnemonic,
+		HDPath:   hdPath,
+		Algo:     algo,
+	}
+
+	privKey, err := DerivePrivKey(accountCfg)
+	if err != nil {
+		return nil, "", err
+	}
+
+	address, err := bech32.ConvertAndEncode(bech32Prefix, privKey.PubKey().Address())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return accountCfg, address, nil
+}
@@ -0,0 +1,184 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+func TestNewMnemonic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     types.MnemonicConfig
+		shouldErr  bool
+		wordsCount int
+	}{
+		{
+			name:       "default config returns 24 english words",
+			config:     types.MnemonicConfig{},
+			wordsCount: 24,
+		},
+		{
+			name:       "128 bits of entropy return 12 words",
+			config:     types.MnemonicConfig{EntropySize: 128, Language: types.LanguageItalian},
+			wordsCount: 12,
+		},
+		{
+			name:       "japanese mnemonic is generated properly",
+			config:     types.MnemonicConfig{EntropySize: 160, Language: types.LanguageJapanese},
+			wordsCount: 15,
+		},
+		{
+			name:      "invalid entropy size returns error",
+			config:    types.MnemonicConfig{EntropySize: 100},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid language returns error",
+			config:    types.MnemonicConfig{Language: "klingon"},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mnemonic, err := types.NewMnemonic(tc.config)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, strings.Fields(mnemonic), tc.wordsCount)
+			require.NoError(t, types.ValidateMnemonic(mnemonic))
+		})
+	}
+}
+
+// bip39Vector represents a BIP39 test vector
+type bip39Vector struct {
+	language   string
+	entropy    string
+	passphrase string
+	mnemonic   string
+	seed       string
+}
+
+// bip39Vectors contains the official BIP39 test vectors for the English (https://github.com/trezor/python-mnemonic)
+// and Japanese (https://github.com/bip32JP/bip32JP.github.io) word lists
+var bip39Vectors = []bip39Vector{
+	{
+		language:   types.LanguageEnglish,
+		entropy:    "00000000000000000000000000000000",
+		passphrase: "TREZOR",
+		mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seed:       "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		language:   types.LanguageEnglish,
+		entropy:    "80808080808080808080808080808080",
+		passphrase: "TREZOR",
+		mnemonic:   "letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		seed:       "d71de856f81a8acc65e6fc851a38d4d7ec216fd0796d0a6827a3ad6ed5511a30fa280f12eb2e47ed2ac03b5c462a0358d18d69fe4f985ec81778c1b370b652a8",
+	},
+	{
+		language:   types.LanguageEnglish,
+		entropy:    "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		passphrase: "TREZOR",
+		mnemonic:   "legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal will",
+		seed:       "f2b94508732bcbacbcc020faefecfc89feafa6649a5491b8c952cede496c214a0c7b3c392d168748f2d4a612bada0753b52a1c7ac53c1e93abd5c6320b9e95dd",
+	},
+	{
+		language:   types.LanguageEnglish,
+		entropy:    "0000000000000000000000000000000000000000000000000000000000000000",
+		passphrase: "TREZOR",
+		mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		seed:       "bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+	},
+	{
+		language:   types.LanguageJapanese,
+		entropy:    "00000000000000000000000000000000",
+		passphrase: "㍍ガバヴァぱばぐゞちぢ十人十色",
+		mnemonic:   "あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら",
+		seed:       "a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55",
+	},
+	{
+		language:   types.LanguageJapanese,
+		entropy:    "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		passphrase: "㍍ガバヴァぱばぐゞちぢ十人十色",
+		mnemonic:   "そつう　れきだい　ほんやく　わかす　りくつ　ばいか　ろせん　やちん　そつう　れきだい　ほんやく　わかめ",
+		seed:       "aee025cbe6ca256862f889e48110a6a382365142f7d16f2b9545285b3af64e542143a577e9c144e101a6bdca18f8d97ec3366ebf5b088b1c1af9bc31346e60d9",
+	},
+	{
+		language:   types.LanguageJapanese,
+		entropy:    "80808080808080808080808080808080",
+		passphrase: "㍍ガバヴァぱばぐゞちぢ十人十色",
+		mnemonic:   "そとづら　あまど　おおう　あこがれる　いくぶん　けいけん　あたえる　いよく　そとづら　あまど　おおう　あかちゃん",
+		seed:       "e51736736ebdf77eda23fa17e31475fa1d9509c78f1deb6b4aacfbd760a7e2ad769c714352c95143b5c1241985bcb407df36d64e75dd5a2b78ca5d2ba82a3544",
+	},
+	{
+		language:   types.LanguageJapanese,
+		entropy:    "ffffffffffffffffffffffffffffffff",
+		passphrase: "㍍ガバヴァぱばぐゞちぢ十人十色",
+		mnemonic:   "われる　われる　われる　われる　われる　われる　われる　われる　われる　われる　われる　ろんぶん",
+		seed:       "4cd2ef49b479af5e1efbbd1e0bdc117f6a29b1010211df4f78e2ed40082865793e57949236c43b9fe591ec70e5bb4298b8b71dc4b267bb96ed4ed282c8f7761c",
+	},
+}
+
+func TestNewMnemonicFromEntropy(t *testing.T) {
+	for _, vector := range bip39Vectors {
+		entropy, err := hex.DecodeString(vector.entropy)
+		require.NoError(t, err)
+
+		mnemonic, err := types.NewMnemonicFromEntropy(entropy, vector.language)
+		require.NoError(t, err)
+		require.Equal(t, vector.mnemonic, mnemonic)
+	}
+
+	_, err := types.NewMnemonicFromEntropy(make([]byte, 10), types.LanguageEnglish)
+	require.Error(t, err)
+
+	_, err = types.NewMnemonicFromEntropy(make([]byte, 16), "klingon")
+	require.Error(t, err)
+}
+
+func TestNewSeed(t *testing.T) {
+	for _, vector := range bip39Vectors {
+		seed, err := types.NewSeed(vector.mnemonic, vector.passphrase)
+		require.NoError(t, err)
+		require.Equal(t, vector.seed, hex.EncodeToString(seed))
+	}
+
+	_, err := types.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", "")
+	require.Error(t, err)
+}
+
+func TestDerivePrivKey(t *testing.T) {
+	accountCfg := &types.AccountConfig{
+		Mnemonic:   "forward service profit benefit punch catch fan chief jealous steel harvest column spell rude warm home melody hat broccoli pulse say garlic you firm",
+		HDPath:     "m/44'/852'/0'/0/0",
+		Passphrase: "passphrase",
+	}
+
+	// Make sure the derivation is the same as the one performed by the Cosmos SDK
+	derivedPriv, err := hd.Secp256k1.Derive()(accountCfg.Mnemonic, accountCfg.Passphrase, accountCfg.HDPath)
+	require.NoError(t, err)
+
+	privKey, err := types.DerivePrivKey(accountCfg)
+	require.NoError(t, err)
+	require.Equal(t, derivedPriv, privKey.Bytes())
+}
+
+func TestGenerateAccount(t *testing.T) {
+	accountCfg, address, err := types.GenerateAccount("cosmos", "m/44'/118'/0'/0/0", "", types.MnemonicConfig{})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(address, "cosmos1"))
+	require.Equal(t, "m/44'/118'/0'/0/0", accountCfg.HDPath)
+	require.NoError(t, types.ValidateMnemonic(accountCfg.Mnemonic))
+}
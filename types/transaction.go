@@ -1,7 +1,9 @@
 package types
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
@@ -14,7 +16,35 @@ type TransactionData struct {
 	FeeAmount  sdk.Coins
 	FeeAuto    bool
 	FeeGranter sdk.AccAddress
+	FeePayer   sdk.AccAddress
 	Sequence   *uint64
+	SignMode   txsigning.SignMode
+	Offline    *OfflineSignerData
+
+	// TimeoutHeight is the block height after which the transaction will no longer be valid
+	TimeoutHeight uint64
+
+	// TimeoutBlocks is the number of blocks, starting from the current height, after which
+	// the transaction will no longer be valid
+	TimeoutBlocks uint64
+
+	// ExtensionOptions contains the extension options that should be set inside the transaction body
+	ExtensionOptions []*codectypes.Any
+
+	// NonCriticalExtensionOptions contains the non-critical extension options that should be set inside
+	// the transaction body
+	NonCriticalExtensionOptions []*codectypes.Any
+
+	// CheckFeeGrant tells whether the fee allowance given by the fee granter should be checked before signing
+	CheckFeeGrant bool
+}
+
+// OfflineSignerData contains the data that is usually read from the chain when signing a transaction.
+// When it is set, transactions are signed without performing any network call
+type OfflineSignerData struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
 }
 
 // NewTransactionData builds a new TransactionData instance
@@ -61,12 +91,87 @@ func (t *TransactionData) WithFeeGranter(granter sdk.AccAddress) *TransactionDat
 	return t
 }
 
+// WithTimeoutHeight allows to set the block height after which the transaction will no longer be valid.
+// It replaces any value previously set using WithTimeoutBlocks
+func (t *TransactionData) WithTimeoutHeight(height uint64) *TransactionData {
+	t.TimeoutHeight = height
+	t.TimeoutBlocks = 0
+	return t
+}
+
+// WithTimeoutBlocks allows to set the number of blocks after which the transaction will no longer be valid.
+// The timeout height is computed by adding the given number of blocks to the current height when building the
+// transaction. It replaces any value previously set using WithTimeoutHeight
+func (t *TransactionData) WithTimeoutBlocks(blocks uint64) *TransactionData {
+	t.TimeoutBlocks = blocks
+	t.TimeoutHeight = 0
+	return t
+}
+
+// WithExtensionOptions allows to set the given extension options.
+// Extension options are required by some chains (e.g. Ethermint-based ones) to process the transaction
+func (t *TransactionData) WithExtensionOptions(options ...*codectypes.Any) *TransactionData {
+	t.ExtensionOptions = options
+	return t
+}
+
+// WithNonCriticalExtensionOptions allows to set the given non-critical extension options.
+// Chains that do not know these options will ignore them
+func (t *TransactionData) WithNonCriticalExtensionOptions(options ...*codectypes.Any) *TransactionData {
+	t.NonCriticalExtensionOptions = options
+	return t
+}
+
+// WithFeeGrantCheck allows to check, before signing the transaction, that the fee granter has given an allowance to
+// the account paying for the fees, and that such allowance can cover the transaction fees and messages.
+// This requires the allowance to be read from the chain, so it cannot be used when signing offline
+func (t *TransactionData) WithFeeGrantCheck() *TransactionData {
+	t.CheckFeeGrant = true
+	return t
+}
+
+// WithFeePayer allows to set the given fee payer that will pay for fees.
+// Since the fee payer must sign the transaction as well, when it is not one of the messages signers
+// the transaction must be built using wallet.BuildMultiSignerTx
+func (t *TransactionData) WithFeePayer(payer sdk.AccAddress) *TransactionData {
+	t.FeePayer = payer
+	return t
+}
+
 // WithSequence allows to set the given sequence
 func (t *TransactionData) WithSequence(sequence uint64) *TransactionData {
 	t.Sequence = &sequence
 	return t
 }
 
+// WithOfflineSignerData allows to set the data that should be used to sign the transaction without having
+// access to the chain. When using this option, both the gas limit and the fee amount must be set explicitly,
+// since the transaction cannot be simulated and the gas prices cannot be read
+func (t *TransactionData) WithOfflineSignerData(chainID string, accountNumber uint64, sequence uint64) *TransactionData {
+	t.Offline = &OfflineSignerData{
+		ChainID:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+	}
+	return t
+}
+
+// WithSignMode allows to set the sign mode that should be used when signing the transaction.
+// The sign mode must be enabled inside the TxConfig of the client (e.g. SIGN_MODE_LEGACY_AMINO_JSON)
+func (t *TransactionData) WithSignMode(signMode txsigning.SignMode) *TransactionData {
+	t.SignMode = signMode
+	return t
+}
+
+// GetSignMode returns the sign mode that should be used when signing the transaction.
+// If no sign mode has been set, SIGN_MODE_DIRECT is returned
+func (t *TransactionData) GetSignMode() txsigning.SignMode {
+	if t.SignMode == txsigning.SignMode_SIGN_MODE_UNSPECIFIED {
+		return txsigning.SignMode_SIGN_MODE_DIRECT
+	}
+	return t.SignMode
+}
+
 // TransactionResponse contains all the data about a transaction response
 type TransactionResponse struct {
 	// Response is the response of the transaction broadcast
@@ -2,14 +2,21 @@ package types
 
 import (
 	"crypto/tls"
+	"fmt"
+	"io"
 	"regexp"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1"
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1/injective"
 	"github.com/riccardom/cosmos-go-wallet/gprc"
 )
 
@@ -41,3 +48,29 @@ func CreateGrpcConnection(config *ChainConfig, codec codec.Codec) (grpc.ClientCo
 	// Create the gRPC connection
 	return grpc.Dial(grpcAddress, grpc.WithTransportCredentials(transportCredentials))
 }
+
+// ComputeFees returns the fees that should be paid for the given amount of gas using the provided gas price
+func ComputeFees(gasPrice sdk.DecCoin, gas uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.MulInt64(int64(gas)).Ceil().RoundInt()))
+}
+
+// CreateKeyring creates a new keyring instance from the given configuration.
+// The user input is used by the file backend to read the keyring passphrase.
+func CreateKeyring(config *KeyringConfig, codec codec.Codec, userInput io.Reader) (keyring.Keyring, error) {
+	return keyring.New(config.AppName, config.Backend, config.Dir, userInput, codec)
+}
+
+// GetSignatureAlgo returns the signature algorithm having the given name.
+// If the name is empty, the secp256k1 algorithm is returned
+func GetSignatureAlgo(name string) (keyring.SignatureAlgo, error) {
+	switch name {
+	case "", string(hd.Secp256k1Type):
+		return hd.Secp256k1, nil
+	case string(ethsecp256k1.EthSecp256k1Type):
+		return ethsecp256k1.EthSecp256k1, nil
+	case injective.AlgoName:
+		return injective.EthSecp256k1, nil
+	default:
+		return nil, fmt.Errorf("unsupported signature algorithm: %s", name)
+	}
+}
@@ -0,0 +1,59 @@
+package wallet
+
+import (
+	"sync"
+)
+
+// accountState contains the state of an account that can be shared by multiple wallets
+type accountState struct {
+	// mu must be held while signing and broadcasting a transaction using the account
+	mu sync.Mutex
+
+	// sequenceTracker keeps track of the account sequence for the wallets having the sequence tracking enabled
+	sequenceTracker *sequenceTracker
+}
+
+// newAccountState returns a new accountState instance
+func newAccountState() *accountState {
+	return &accountState{
+		sequenceTracker: newSequenceTracker(),
+	}
+}
+
+// AccountsRegistry contains the state of the accounts used by a set of wallets, so that multiple wallets of the same
+// account share the same lock and tracked sequence. The registry is owned by the caller, and the states it contains
+// are released along with it
+type AccountsRegistry struct {
+	mu     sync.Mutex
+	states map[string]*accountState
+}
+
+// NewAccountsRegistry returns a new, empty AccountsRegistry instance
+func NewAccountsRegistry() *AccountsRegistry {
+	return &AccountsRegistry{
+		states: map[string]*accountState{},
+	}
+}
+
+// getState returns the state of the account having the given Bech32 address, creating it if needed
+func (r *AccountsRegistry) getState(address string) *accountState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	state, ok := r.states[address]
+	if !ok {
+		state = newAccountState()
+		r.states[address] = state
+	}
+	return state
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// WithAccountsRegistry makes this wallet share the state of its account with all the other wallets using the given
+// registry, so that their transactions are serialized and, if the sequence tracking is enabled, the tracked sequence
+// is shared. It must be called before using the wallet
+func (w *Wallet) WithAccountsRegistry(registry *AccountsRegistry) *Wallet {
+	w.account = registry.getState(w.AccAddress())
+	return w
+}
@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// adr036SignDoc represents the Amino JSON sign document of an ADR-036 off-chain message.
+// Fields are sorted alphabetically so that the resulting JSON is the canonical one
+type adr036SignDoc struct {
+	AccountNumber string      `json:"account_number"`
+	ChainID       string      `json:"chain_id"`
+	Fee           adr036Fee   `json:"fee"`
+	Memo          string      `json:"memo"`
+	Msgs          []adr036Msg `json:"msgs"`
+	Sequence      string      `json:"sequence"`
+}
+
+type adr036Fee struct {
+	Amount []sdk.Coin `json:"amount"`
+	Gas    string     `json:"gas"`
+}
+
+type adr036Msg struct {
+	Type  string             `json:"type"`
+	Value adr036MsgSignValue `json:"value"`
+}
+
+type adr036MsgSignValue struct {
+	Data   string `json:"data"`
+	Signer string `json:"signer"`
+}
+
+// GetADR036SignBytes returns the bytes that should be signed by the given signer in order to
+// sign the provided data as an ADR-036 off-chain MsgSignData message
+func GetADR036SignBytes(signer string, data []byte) ([]byte, error) {
+	signDoc := adr036SignDoc{
+		AccountNumber: "0",
+		ChainID:       "",
+		Fee: adr036Fee{
+			Amount: []sdk.Coin{},
+			Gas:    "0",
+		},
+		Memo: "",
+		Msgs: []adr036Msg{
+			{
+				Type: "sign/MsgSignData",
+				Value: adr036MsgSignValue{
+					Data:   base64.StdEncoding.EncodeToString(data),
+					Signer: signer,
+				},
+			},
+		},
+		Sequence: "0",
+	}
+
+	bz, err := json.Marshal(signDoc)
+	if err != nil {
+		return nil, fmt.Errorf("error while serializing ADR-036 sign doc: %s", err)
+	}
+	return bz, nil
+}
+
+// SignArbitrary signs the given data following the ADR-036 specification, so that it can be used to
+// prove the ownership of this wallet address. The returned signature can be checked using VerifyArbitrary
+func (w *Wallet) SignArbitrary(data []byte) ([]byte, error) {
+	signBytes, err := GetADR036SignBytes(w.AccAddress(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.signer.Sign(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signBytes)
+}
+
+// SignArbitraryString signs the given string following the ADR-036 specification
+func (w *Wallet) SignArbitraryString(data string) ([]byte, error) {
+	return w.SignArbitrary([]byte(data))
+}
+
+// VerifyArbitrary checks that the given signature has been created following the ADR-036 specification by the
+// account having the provided address and public key. It returns an error if the signature is not valid
+func VerifyArbitrary(parser AddressParser, address string, data []byte, pubKey cryptotypes.PubKey, signature []byte) error {
+	accAddress, err := parser.ParseAddress(address)
+	if err != nil {
+		return fmt.Errorf("invalid signer address: %s", err)
+	}
+
+	if !bytes.Equal(accAddress, pubKey.Address()) {
+		return fmt.Errorf("public key does not match the signer address")
+	}
+
+	// Use the address as it was encoded by the signer, so that the sign bytes are the same
+	prefix, _, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return fmt.Errorf("invalid signer address: %s", err)
+	}
+
+	signer, err := bech32.ConvertAndEncode(prefix, accAddress)
+	if err != nil {
+		return err
+	}
+
+	signBytes, err := GetADR036SignBytes(signer, data)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return fmt.Errorf("invalid signature")
+	}
+
+	return nil
+}
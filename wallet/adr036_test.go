@@ -0,0 +1,50 @@
+package wallet_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/client"
+	"github.com/riccardom/cosmos-go-wallet/testutils"
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+func TestGetADR036SignBytes(t *testing.T) {
+	signBytes, err := wallet.GetADR036SignBytes("cosmos1q62k9kvjy7v2wh0yt9jqaepnzezz3s49j9gnpk", []byte("Hello world"))
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"SGVsbG8gd29ybGQ=","signer":"cosmos1q62k9kvjy7v2wh0yt9jqaepnzezz3s49j9gnpk"}}],"sequence":"0"}`,
+		string(signBytes),
+	)
+}
+
+func TestVerifyArbitrary(t *testing.T) {
+	w, _ := newTestWallet(t)
+
+	encodingCfg := testutils.MakeTestEncodingConfig()
+	parser := client.NewClient("cosmos", nil, nil, nil, encodingCfg.TxConfig, encodingCfg.Codec)
+
+	signature, err := w.SignArbitraryString("Hello world")
+	require.NoError(t, err)
+
+	pubKey := w.Signer().PubKey()
+	require.NoError(t, wallet.VerifyArbitrary(parser, w.AccAddress(), []byte("Hello world"), pubKey, signature))
+
+	// Wrong data
+	require.Error(t, wallet.VerifyArbitrary(parser, w.AccAddress(), []byte("Hello"), pubKey, signature))
+
+	// Wrong public key
+	otherPubKey := secp256k1.GenPrivKey().PubKey()
+	require.Error(t, wallet.VerifyArbitrary(parser, w.AccAddress(), []byte("Hello world"), otherPubKey, signature))
+
+	// Wrong address
+	otherAddress := sdk.MustBech32ifyAddressBytes("cosmos", otherPubKey.Address())
+	require.Error(t, wallet.VerifyArbitrary(parser, otherAddress, []byte("Hello world"), pubKey, signature))
+
+	// Wrong prefix
+	wrongPrefixAddress := sdk.MustBech32ifyAddressBytes("desmos", pubKey.Address())
+	require.Error(t, wallet.VerifyArbitrary(parser, wrongPrefixAddress, []byte("Hello world"), pubKey, signature))
+}
@@ -0,0 +1,217 @@
+package wallet
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+// BatcherConfig contains the configuration of a Batcher
+type BatcherConfig struct {
+	// MaxMessages is the maximum number of messages that can be included inside a single transaction.
+	// When zero, there is no limit on the number of messages
+	MaxMessages int
+
+	// MaxGas is the maximum amount of estimated gas that a single transaction can consume.
+	// When zero, the gas of the messages is not estimated
+	MaxGas uint64
+
+	// FlushInterval is the maximum amount of time that a message can wait before being broadcasted.
+	// When zero, messages are broadcasted only when one of the other limits is reached or Flush is called
+	FlushInterval time.Duration
+
+	// GasEstimator, if set, is used to estimate the gas consumed by each message.
+	// When nil, the gas is estimated by simulating a transaction containing only the message
+	GasEstimator func(msg sdk.Msg) (uint64, error)
+
+	// BuildTxData, if set, is used to build the data of the transactions containing the batched messages.
+	// When nil, the gas and the fees of each transaction are computed automatically
+	BuildTxData func(msgs []sdk.Msg) *types.TransactionData
+}
+
+// DefaultBatcherConfig returns the default BatcherConfig instance
+func DefaultBatcherConfig() BatcherConfig {
+	return BatcherConfig{
+		MaxMessages:   100,
+		FlushInterval: 5 * time.Second,
+	}
+}
+
+// BatchResult contains the result of the broadcast of a message that has been batched
+type BatchResult struct {
+	// Response is the response of the transaction that contained the message
+	Response types.TransactionResponse
+
+	// MsgIndex is the index of the message inside the transaction
+	MsgIndex int
+
+	// Err is the error that occurred while building or broadcasting the transaction, if any
+	Err error
+}
+
+// batchItem represents a message waiting to be broadcasted
+type batchItem struct {
+	msg    sdk.Msg
+	gas    uint64
+	result chan BatchResult
+}
+
+// Batcher allows to broadcast messages coming from multiple goroutines by packing them into
+// as few transactions as possible, all of which are signed by the same wallet
+type Batcher struct {
+	wallet *Wallet
+	config BatcherConfig
+
+	mu sync.Mutex
+
+	// batchID is the id of the current batch, used to make sure that each timer only flushes its own batch
+	batchID    uint64
+	pending    []*batchItem
+	pendingGas uint64
+	timer      *time.Timer
+	stopped    bool
+
+	// wg is used to wait for all the transactions that are being broadcasted
+	wg sync.WaitGroup
+}
+
+// NewBatcher returns a new Batcher instance that uses the given wallet to broadcast the transactions.
+// Since a batch can be broadcasted before the previous ones are included inside a block,
+// the sequence tracking of the wallet is enabled
+func NewBatcher(wallet *Wallet, config BatcherConfig) *Batcher {
+	wallet = wallet.WithSequenceTracking()
+
+	if config.GasEstimator == nil {
+		config.GasEstimator = func(msg sdk.Msg) (uint64, error) {
+			return wallet.EstimateGas(msg)
+		}
+	}
+
+	if config.BuildTxData == nil {
+		config.BuildTxData = func(msgs []sdk.Msg) *types.TransactionData {
+			return types.NewTransactionData(msgs...).WithGasAuto().WithFeeAuto()
+		}
+	}
+
+	return &Batcher{
+		wallet: wallet,
+		config: config,
+	}
+}
+
+// Add adds the given message to the current batch. The returned channel will receive the
+// result of the broadcast of the transaction containing the message once it has been flushed
+func (b *Batcher) Add(msg sdk.Msg) (<-chan BatchResult, error) {
+	var gas uint64
+	if b.config.MaxGas > 0 {
+		estimated, err := b.config.GasEstimator(msg)
+		if err != nil {
+			return nil, fmt.Errorf("error while estimating the message gas: %s", err)
+		}
+		gas = estimated
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.stopped {
+		return nil, fmt.Errorf("error while adding the message: batcher has been stopped")
+	}
+
+	// Flush the current batch if the message would make it go over the gas limit
+	if b.config.MaxGas > 0 && len(b.pending) > 0 && b.pendingGas+gas > b.config.MaxGas {
+		b.flush()
+	}
+
+	item := &batchItem{msg: msg, gas: gas, result: make(chan BatchResult, 1)}
+	b.pending = append(b.pending, item)
+	b.pendingGas += gas
+
+	if len(b.pending) == 1 && b.config.FlushInterval > 0 {
+		batchID := b.batchID
+		b.timer = time.AfterFunc(b.config.FlushInterval, func() {
+			b.flushBatch(batchID)
+		})
+	}
+
+	if b.config.MaxMessages > 0 && len(b.pending) >= b.config.MaxMessages {
+		b.flush()
+	}
+
+	return item.result, nil
+}
+
+// Flush broadcasts all the messages of the current batch
+func (b *Batcher) Flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.flush()
+}
+
+// Stop flushes the current batch and waits for all the transactions to be broadcasted.
+// After being stopped, the batcher no longer accepts new messages
+func (b *Batcher) Stop() {
+	b.mu.Lock()
+	b.stopped = true
+	b.flush()
+	b.mu.Unlock()
+
+	b.wg.Wait()
+}
+
+// flushBatch flushes the current batch only if it has the given id
+func (b *Batcher) flushBatch(batchID uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.batchID == batchID {
+		b.flush()
+	}
+}
+
+// flush broadcasts all the pending messages inside a single transaction and starts a new batch.
+// NOTE: The caller must hold the batcher lock
+func (b *Batcher) flush() {
+	if len(b.pending) == 0 {
+		return
+	}
+
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	items := b.pending
+	b.batchID++
+	b.pending = nil
+	b.pendingGas = 0
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		b.broadcast(items)
+	}()
+}
+
+// broadcast broadcasts a transaction containing the messages of the given items,
+// and sends the result to each of them
+func (b *Batcher) broadcast(items []*batchItem) {
+	msgs := make([]sdk.Msg, len(items))
+	for i, item := range items {
+		msgs[i] = item.msg
+	}
+
+	response, err := b.wallet.BroadcastTxSync(b.config.BuildTxData(msgs))
+	for i, item := range items {
+		item.result <- BatchResult{
+			Response: response,
+			MsgIndex: i,
+			Err:      err,
+		}
+		close(item.result)
+	}
+}
@@ -0,0 +1,115 @@
+package wallet_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+func TestBatcher_MaxMessages(t *testing.T) {
+	w, client := newTestWallet(t)
+	batcher := wallet.NewBatcher(w, wallet.BatcherConfig{MaxMessages: 3})
+	client.CheckSequences = true
+
+	// Add the messages from multiple goroutines
+	var wg sync.WaitGroup
+	msgs := make([]sdk.Msg, 7)
+	results := make([]wallet.BatchResult, 7)
+	for i := 0; i < 7; i++ {
+		msgs[i] = newTestMsgSend(w)
+		resultCh, err := batcher.Add(msgs[i])
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = <-resultCh
+		}(i)
+	}
+
+	batcher.Stop()
+	wg.Wait()
+
+	require.Len(t, client.BroadcastedTxs, 3)
+	for i, result := range results {
+		require.NoError(t, result.Err)
+		require.Zero(t, result.Response.Code)
+		require.Equal(t, i%3, result.MsgIndex)
+		require.Equal(t, msgs[i], result.Response.Tx.GetMsgs()[result.MsgIndex])
+	}
+
+	_, err := batcher.Add(newTestMsgSend(w))
+	require.Error(t, err)
+}
+
+func TestBatcher_DefaultConfig(t *testing.T) {
+	w, client := newTestWallet(t)
+	client.CheckSequences = true
+
+	config := wallet.DefaultBatcherConfig()
+	config.MaxMessages = 2
+	batcher := wallet.NewBatcher(w, config)
+
+	// Add all the messages before any transaction is included inside a block
+	resultChs := make([]<-chan wallet.BatchResult, 6)
+	for i := range resultChs {
+		resultCh, err := batcher.Add(newTestMsgSend(w))
+		require.NoError(t, err)
+		resultChs[i] = resultCh
+	}
+	batcher.Stop()
+
+	require.Len(t, client.BroadcastedTxs, 3)
+	for _, resultCh := range resultChs {
+		result := <-resultCh
+		require.NoError(t, result.Err)
+		require.Zero(t, result.Response.Code)
+	}
+}
+
+func TestBatcher_MaxGas(t *testing.T) {
+	w, client := newTestWallet(t)
+	batcher := wallet.NewBatcher(w, wallet.BatcherConfig{
+		MaxGas: 250,
+		GasEstimator: func(_ sdk.Msg) (uint64, error) {
+			return 100, nil
+		},
+	})
+
+	for i := 0; i < 5; i++ {
+		_, err := batcher.Add(newTestMsgSend(w))
+		require.NoError(t, err)
+	}
+	batcher.Stop()
+
+	// Batches are broadcasted concurrently, so their order is not deterministic
+	var msgsCount []int
+	for _, tx := range client.BroadcastedTxs {
+		msgsCount = append(msgsCount, len(tx.GetMsgs()))
+	}
+	require.ElementsMatch(t, []int{2, 2, 1}, msgsCount)
+}
+
+func TestBatcher_FlushInterval(t *testing.T) {
+	w, client := newTestWallet(t)
+	batcher := wallet.NewBatcher(w, wallet.BatcherConfig{FlushInterval: 10 * time.Millisecond})
+	defer batcher.Stop()
+
+	msg := newTestMsgSend(w)
+	resultCh, err := batcher.Add(msg)
+	require.NoError(t, err)
+
+	select {
+	case result := <-resultCh:
+		require.NoError(t, result.Err)
+		require.Equal(t, []sdk.Msg{msg}, result.Response.Tx.GetMsgs())
+	case <-time.After(time.Second):
+		require.Fail(t, "batch not flushed")
+	}
+	require.Len(t, client.BroadcastedTxs, 1)
+}
@@ -0,0 +1,387 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+// buildUnsignedTx creates a transaction with the provided data, setting an empty signature for each of the accounts
+// having the given public keys, which must be sorted as the transaction signers. It returns the accounts, the builder
+// and the data that each account should use when signing it.
+// The sequence and the offline signer data contained inside the transaction data are used for the first account
+func buildUnsignedTx(client Client, pubKeys []cryptotypes.PubKey, data *types.TransactionData) ([]sdk.AccountI, sdkclient.TxBuilder, []authsigning.SignerData, error) {
+	if data.Offline != nil && len(pubKeys) > 1 {
+		return nil, nil, nil, fmt.Errorf("error while building an offline transaction: only one signer is supported")
+	}
+
+	addresses := make([]string, len(pubKeys))
+	accounts := make([]sdk.AccountI, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		address, err := bech32.ConvertAndEncode(client.GetAccountPrefix(), pubKey.Address())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		addresses[i] = address
+
+		// Get the account
+		accounts[i], err = getAccount(client, pubKey, address, data)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	// Set account sequence
+	if data.Sequence != nil && *data.Sequence > 0 {
+		err := accounts[0].SetSequence(*data.Sequence)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error while setting the account sequence: %s", err)
+		}
+	}
+
+	// Build the transaction
+	builder := client.GetTxConfig().NewTxBuilder()
+	if data.Memo != "" {
+		builder.SetMemo(data.Memo)
+	}
+	if data.FeeGranter != nil {
+		builder.SetFeeGranter(data.FeeGranter)
+	}
+	if data.FeePayer != nil {
+		if !containsAddress(pubKeys, data.FeePayer) {
+			return nil, nil, nil, fmt.Errorf("error while setting the fee payer: %s must be one of the transaction signers", data.FeePayer)
+		}
+		builder.SetFeePayer(data.FeePayer)
+	}
+
+	err := setExtensionOptions(builder, data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	timeoutHeight, err := getTimeoutHeight(client, data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if timeoutHeight > 0 {
+		builder.SetTimeoutHeight(timeoutHeight)
+	}
+
+	if len(data.Messages) == 0 {
+		return nil, nil, nil, fmt.Errorf("error while building a transaction with no messages")
+	}
+
+	err = builder.SetMsgs(data.Messages...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	signMode := data.GetSignMode()
+
+	gasLimit := data.GasLimit
+	if data.GasAuto {
+		if data.Offline != nil {
+			return nil, nil, nil, fmt.Errorf("error while building an offline transaction: gas cannot be simulated")
+		}
+
+		adjusted, err := simulateTx(client, pubKeys, accounts, builder, signMode)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		gasLimit = adjusted
+	}
+
+	feeAmount := data.FeeAmount
+	if data.FeeAuto {
+		// Compute the fee amount based on the gas limit and the gas price
+		feeAmount, err = getAutoFees(client, addresses[0], gasLimit, data)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if data.CheckFeeGrant {
+		err = checkFeeGrant(client, addresses[0], feeAmount, data)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	// Set the new gas and fee
+	builder.SetGasLimit(gasLimit)
+	builder.SetFeeAmount(feeAmount)
+
+	// Set empty signatures first
+	err = builder.SetSignatures(newEmptySignatures(pubKeys, accounts, signMode)...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	chainID, err := getChainID(client, data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	signersData := make([]authsigning.SignerData, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		signersData[i] = authsigning.SignerData{
+			Address:       addresses[i],
+			ChainID:       chainID,
+			AccountNumber: accounts[i].GetAccountNumber(),
+			Sequence:      accounts[i].GetSequence(),
+			PubKey:        pubKey,
+		}
+	}
+
+	return accounts, builder, signersData, nil
+}
+
+// getAccount returns the account having the given public key and address that should sign the transaction.
+// If the transaction should be signed offline, the account is built without reading it from the chain
+func getAccount(client Client, pubKey cryptotypes.PubKey, address string, data *types.TransactionData) (sdk.AccountI, error) {
+	if data.Offline != nil {
+		return authtypes.NewBaseAccount(pubKey.Address().Bytes(), pubKey, data.Offline.AccountNumber, data.Offline.Sequence), nil
+	}
+
+	account, err := client.GetAccount(address)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the account from the chain: %s", err)
+	}
+	return account, nil
+}
+
+// getChainID returns the chain id that should be used to sign the transaction having the given data
+func getChainID(client Client, data *types.TransactionData) (string, error) {
+	if data.Offline != nil {
+		return data.Offline.ChainID, nil
+	}
+	return client.GetChainID()
+}
+
+// setExtensionOptions sets the extension options contained inside the given data using the provided builder
+func setExtensionOptions(builder sdkclient.TxBuilder, data *types.TransactionData) error {
+	if len(data.ExtensionOptions) == 0 && len(data.NonCriticalExtensionOptions) == 0 {
+		return nil
+	}
+
+	extBuilder, ok := builder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return fmt.Errorf("error while setting the extension options: tx builder does not support them")
+	}
+
+	if len(data.ExtensionOptions) > 0 {
+		extBuilder.SetExtensionOptions(data.ExtensionOptions...)
+	}
+	if len(data.NonCriticalExtensionOptions) > 0 {
+		extBuilder.SetNonCriticalExtensionOptions(data.NonCriticalExtensionOptions...)
+	}
+	return nil
+}
+
+// containsAddress tells whether the given address belongs to any of the provided public keys
+func containsAddress(pubKeys []cryptotypes.PubKey, address sdk.AccAddress) bool {
+	for _, pubKey := range pubKeys {
+		if address.Equals(sdk.AccAddress(pubKey.Address())) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAutoFees returns the fees that should be paid for a transaction having the given data and gas limit, using
+// the first accepted fee denom that the account paying for the fees can afford. If the account cannot afford the
+// fees using any of the denoms, an InsufficientFeesError is returned
+func getAutoFees(client Client, signer string, gasLimit uint64, data *types.TransactionData) (sdk.Coins, error) {
+	// The gas price provider might need to query the chain (e.g. when using the fee market module)
+	if data.Offline != nil {
+		return nil, fmt.Errorf("error while building an offline transaction: fees cannot be computed, please set them explicitly")
+	}
+
+	gasPrices, err := client.GetGasPrices()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the gas prices: %s", err)
+	}
+
+	if len(gasPrices) == 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	payer, err := getFeesPayer(client, signer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Locked tokens (e.g. vesting ones) cannot be used to pay for fees, so only the spendable balance is considered
+	balance, err := client.GetSpendableBalances(payer)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the spendable balance of the fees payer: %s", err)
+	}
+
+	fees := make([]sdk.Coins, len(gasPrices))
+	for i, gasPrice := range gasPrices {
+		fees[i] = types.ComputeFees(gasPrice, gasLimit)
+		if balance.IsAllGTE(fees[i]) {
+			return fees[i], nil
+		}
+	}
+
+	return nil, &InsufficientFeesError{
+		Address: payer,
+		Balance: balance,
+		Fees:    fees,
+	}
+}
+
+// getFeesPayer returns the address of the account that will pay for the fees of the transaction having the given
+// data, which can be either the fee granter, the fee payer or the first signer
+func getFeesPayer(client Client, signer string, data *types.TransactionData) (string, error) {
+	payer := data.FeeGranter
+	if payer == nil {
+		payer = data.FeePayer
+	}
+	if payer == nil {
+		return signer, nil
+	}
+
+	address, err := bech32.ConvertAndEncode(client.GetAccountPrefix(), payer)
+	if err != nil {
+		return "", fmt.Errorf("error while converting the fees payer address: %s", err)
+	}
+	return address, nil
+}
+
+// checkFeeGrant makes sure that the fee granter of the transaction having the given data has given an allowance
+// to the account paying for the fees, and that such allowance accepts the provided fees and the transaction messages.
+// Since the block time is not known in advance, the local time is used to check whether the allowance has expired
+func checkFeeGrant(client Client, signer string, fees sdk.Coins, data *types.TransactionData) error {
+	if data.FeeGranter == nil {
+		return fmt.Errorf("error while checking the fee grant: no fee granter set")
+	}
+
+	if data.Offline != nil {
+		return fmt.Errorf("error while building an offline transaction: fee grant cannot be checked")
+	}
+
+	granter, err := bech32.ConvertAndEncode(client.GetAccountPrefix(), data.FeeGranter)
+	if err != nil {
+		return fmt.Errorf("error while converting the fee granter address: %s", err)
+	}
+
+	grantee := signer
+	if data.FeePayer != nil {
+		grantee, err = bech32.ConvertAndEncode(client.GetAccountPrefix(), data.FeePayer)
+		if err != nil {
+			return fmt.Errorf("error while converting the fee payer address: %s", err)
+		}
+	}
+
+	allowance, err := client.GetFeeAllowance(granter, grantee)
+	if err != nil {
+		return fmt.Errorf("error while getting the fee allowance from %s to %s: %s", granter, grantee, err)
+	}
+
+	// The allowance is evaluated using a context similar to the one of the chain, which is never persisted
+	ctx := sdk.Context{}.
+		WithContext(context.Background()).
+		WithBlockTime(time.Now()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	_, err = allowance.Accept(ctx, fees, data.Messages)
+	if err != nil {
+		return fmt.Errorf("error while checking the fee allowance from %s to %s: %s", granter, grantee, err)
+	}
+
+	return nil
+}
+
+// getTimeoutHeight returns the timeout height that should be set for the transaction having the given data.
+// If the timeout is relative to the current height, the latest height is read from the chain
+func getTimeoutHeight(client Client, data *types.TransactionData) (uint64, error) {
+	if data.TimeoutBlocks == 0 {
+		return data.TimeoutHeight, nil
+	}
+
+	if data.Offline != nil {
+		return 0, fmt.Errorf("error while building an offline transaction: current height cannot be read")
+	}
+
+	height, err := client.GetLatestHeight()
+	if err != nil {
+		return 0, fmt.Errorf("error while getting the latest height: %s", err)
+	}
+	return uint64(height) + data.TimeoutBlocks, nil
+}
+
+// simulateTx simulates the given transaction and returns the amount of adjusted gas that should be used
+func simulateTx(client Client, pubKeys []cryptotypes.PubKey, accounts []sdk.AccountI, builder sdkclient.TxBuilder, signMode signing.SignMode) (uint64, error) {
+	// Create empty signature literals using the signers public keys, so that the
+	// gas consumed by the signatures verification is properly estimated
+	err := builder.SetSignatures(newEmptySignatures(pubKeys, accounts, signMode)...)
+	if err != nil {
+		return 0, err
+	}
+
+	// Set a fake amount of gas and fees
+	builder.SetGasLimit(200_000)
+	gasPrices, err := client.GetGasPrices()
+	if err != nil {
+		return 0, fmt.Errorf("error while getting the gas prices: %s", err)
+	}
+	if len(gasPrices) > 0 {
+		builder.SetFeeAmount(types.ComputeFees(gasPrices[0], 200_000))
+	}
+
+	// Simulate the execution of the transaction
+	adjusted, err := client.SimulateTx(builder.GetTx())
+	if err != nil {
+		return 0, fmt.Errorf("error while simulating tx: %s", err)
+	}
+	return adjusted, nil
+}
+
+// newEmptySignatures returns the signatures, without any actual signature data, for the given public keys
+func newEmptySignatures(pubKeys []cryptotypes.PubKey, accounts []sdk.AccountI, signMode signing.SignMode) []signing.SignatureV2 {
+	sigs := make([]signing.SignatureV2, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		sigs[i] = signing.SignatureV2{
+			PubKey:   pubKey,
+			Data:     newEmptySignatureData(pubKey, signMode),
+			Sequence: accounts[i].GetSequence(),
+		}
+	}
+	return sigs
+}
+
+// newEmptySignatureData returns the signature data, without any actual signature, to be used for the given public key.
+// For multisig public keys, the data contains an empty signature for each of the members
+func newEmptySignatureData(pubKey cryptotypes.PubKey, signMode signing.SignMode) signing.SignatureData {
+	multisigPubKey, ok := pubKey.(multisigtypes.PubKey)
+	if !ok {
+		return &signing.SingleSignatureData{
+			SignMode: signMode,
+		}
+	}
+
+	// Multisig members can only sign using SIGN_MODE_LEGACY_AMINO_JSON
+	pubKeys := multisigPubKey.GetPubKeys()
+	sigData := multisigtypes.NewMultisig(len(pubKeys))
+	for i, memberPubKey := range pubKeys {
+		sigData.BitArray.SetIndex(i, true)
+		sigData.Signatures = append(sigData.Signatures, newEmptySignatureData(memberPubKey, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON))
+	}
+	return sigData
+}
@@ -0,0 +1,169 @@
+package wallet_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+func TestWallet_BuildTx_TimeoutHeight(t *testing.T) {
+	w, client := newTestWallet(t)
+	client.Height = 100
+
+	_, builder, err := w.BuildTx(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000).WithTimeoutHeight(150))
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), builder.GetTx().GetTimeoutHeight())
+
+	_, builder, err = w.BuildTx(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000).WithTimeoutBlocks(10))
+	require.NoError(t, err)
+	require.Equal(t, uint64(110), builder.GetTx().GetTimeoutHeight())
+
+	// The current height cannot be read when signing offline
+	data := types.NewTransactionData(newTestMsgSend(w)).
+		WithGasLimit(200_000).
+		WithTimeoutBlocks(10).
+		WithOfflineSignerData("testchain", 1, 0)
+	_, _, err = w.BuildTx(data)
+	require.Error(t, err)
+
+	_, builder, err = w.BuildTx(data.WithTimeoutHeight(150))
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), builder.GetTx().GetTimeoutHeight())
+}
+
+func TestWallet_BuildTx_ExtensionOptions(t *testing.T) {
+	w, client := newTestWallet(t)
+
+	option, err := codectypes.NewAnyWithValue(&banktypes.SendAuthorization{})
+	require.NoError(t, err)
+	nonCriticalOption, err := codectypes.NewAnyWithValue(&banktypes.Params{})
+	require.NoError(t, err)
+
+	data := types.NewTransactionData(newTestMsgSend(w)).
+		WithGasAuto().
+		WithFeeAuto().
+		WithExtensionOptions(option).
+		WithNonCriticalExtensionOptions(nonCriticalOption)
+
+	_, builder, err := w.BuildTx(data)
+	require.NoError(t, err)
+
+	tx, ok := builder.GetTx().(ante.HasExtensionOptionsTx)
+	require.True(t, ok)
+	require.Equal(t, []*codectypes.Any{option}, tx.GetExtensionOptions())
+	require.Equal(t, []*codectypes.Any{nonCriticalOption}, tx.GetNonCriticalExtensionOptions())
+
+	requireValidSignature(t, client, w, builder.GetTx())
+}
+
+func TestWallet_BuildTx_FeeAutoDenom(t *testing.T) {
+	w, client := newTestWallet(t)
+	client.GasPrices = sdk.DecCoins{
+		sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyNewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(2, 2)),
+	}
+
+	// The account can only afford the fees using the second denom
+	client.Balances[w.AccAddress()] = sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10_000)))
+
+	data := types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(100_000).WithFeeAuto()
+	_, builder, err := w.BuildTx(data)
+	require.NoError(t, err)
+	require.Equal(t, "2000stake", builder.GetTx().GetFee().String())
+
+	// The preferred denom should be used when the account can afford it
+	client.Balances[w.AccAddress()] = client.Balances[w.AccAddress()].Add(sdk.NewCoin("uatom", sdkmath.NewInt(1_000)))
+
+	_, builder, err = w.BuildTx(data)
+	require.NoError(t, err)
+	require.Equal(t, "1000uatom", builder.GetTx().GetFee().String())
+
+	// Locked tokens (e.g. vesting ones) cannot be used to pay for the fees
+	client.LockedBalances[w.AccAddress()] = sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(500)))
+
+	_, builder, err = w.BuildTx(data)
+	require.NoError(t, err)
+	require.Equal(t, "2000stake", builder.GetTx().GetFee().String())
+
+	// The balance of the fee granter should be used when it is set
+	granter := secp256k1.GenPrivKey().PubKey().Address().Bytes()
+	_, _, err = w.BuildTx(data.WithFeeGranter(granter))
+	require.NoError(t, err)
+
+	client.Balances[sdk.MustBech32ifyAddressBytes("cosmos", granter)] = sdk.NewCoins()
+	_, _, err = w.BuildTx(data)
+
+	var feesErr *wallet.InsufficientFeesError
+	require.ErrorAs(t, err, &feesErr)
+	require.Equal(t, sdk.MustBech32ifyAddressBytes("cosmos", granter), feesErr.Address)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1_000))),
+		sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(2_000))),
+	}, feesErr.Fees)
+}
+
+func TestWallet_BuildTx_FeeGrantCheck(t *testing.T) {
+	w, client := newTestWallet(t)
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	var allowance feegrant.FeeAllowanceI
+	client.FeeAllowanceFn = func(granterAddr, grantee string) (feegrant.FeeAllowanceI, error) {
+		if allowance == nil || granterAddr != granter.String() || grantee != w.AccAddress() {
+			return nil, fmt.Errorf("fee-grant not found: granter %s, grantee %s", granterAddr, grantee)
+		}
+		return allowance, nil
+	}
+
+	// The fees amount to 1000stake
+	data := types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(100_000).WithFeeAuto().WithFeeGranter(granter)
+
+	// The allowance is not checked unless required
+	_, _, err := w.BuildTx(data)
+	require.NoError(t, err)
+
+	// No allowance has been given
+	_, _, err = w.BuildTx(data.WithFeeGrantCheck())
+	require.Error(t, err)
+
+	// The allowance covers the fees
+	allowance = &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(5_000)))}
+	_, _, err = w.BuildTx(data)
+	require.NoError(t, err)
+
+	// The allowance has expired
+	expiration := time.Now().Add(-time.Hour)
+	allowance = &feegrant.BasicAllowance{Expiration: &expiration}
+	_, _, err = w.BuildTx(data)
+	require.ErrorContains(t, err, "expired")
+
+	// The spend limit does not cover the fees
+	allowance = &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(500)))}
+	_, _, err = w.BuildTx(data)
+	require.ErrorContains(t, err, "fee limit exceeded")
+
+	// The allowance does not allow the transaction messages
+	allowedMsgAllowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, []string{
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	})
+	require.NoError(t, err)
+	allowance = allowedMsgAllowance
+	_, _, err = w.BuildTx(data)
+	require.ErrorContains(t, err, "not allowed")
+
+	// The allowance cannot be read when signing offline
+	allowance = &feegrant.BasicAllowance{}
+	_, _, err = w.BuildTx(data.WithOfflineSignerData("testchain", 1, 0))
+	require.Error(t, err)
+}
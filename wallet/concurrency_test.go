@@ -0,0 +1,60 @@
+package wallet_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+func TestWallet_ConcurrentBroadcasts(t *testing.T) {
+	registry := wallet.NewAccountsRegistry()
+	w1, client := newTestWallet(t)
+	w1 = w1.WithAccountsRegistry(registry)
+	w2 := wallet.NewWalletFromSigner(w1.Signer(), client).WithAccountsRegistry(registry)
+
+	// Keep track of the broadcasts that are happening at the same time
+	var inFlight, maxInFlight int32
+	client.BroadcastFn = func(_ signing.Tx) (*sdk.TxResponse, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		return &sdk.TxResponse{TxHash: fmt.Sprintf("%d", current)}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		w := w1
+		if i%2 == 1 {
+			w = w2
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, client.BroadcastedTxs, 20)
+	require.Equal(t, int32(1), maxInFlight)
+}
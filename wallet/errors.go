@@ -0,0 +1,31 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InsufficientFeesError is returned when the account paying for the fees of a transaction
+// cannot afford them using any of the accepted fee denoms
+type InsufficientFeesError struct {
+	// Address is the address of the account that should pay for the fees
+	Address string
+
+	// Balance is the balance of the account
+	Balance sdk.Coins
+
+	// Fees contains the fees that would be required for each of the accepted fee denoms
+	Fees []sdk.Coins
+}
+
+func (e *InsufficientFeesError) Error() string {
+	fees := make([]string, len(e.Fees))
+	for i, fee := range e.Fees {
+		fees[i] = fee.String()
+	}
+
+	return fmt.Sprintf("insufficient funds to pay for fees: account %s has %s but requires one of [%s]",
+		e.Address, e.Balance, strings.Join(fees, ", "))
+}
@@ -1,9 +1,14 @@
 package wallet
 
 import (
+	"context"
+
+	"cosmossdk.io/x/feegrant"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 type Client interface {
@@ -11,11 +16,42 @@ type Client interface {
 
 	GetAccountPrefix() string
 	GetChainID() (string, error)
+	GetLatestHeight() (int64, error)
 	GetAccount(address string) (sdk.AccountI, error)
-	GetFees(gas int64) sdk.Coins
+	GetBalances(address string) (sdk.Coins, error)
+	GetSpendableBalances(address string) (sdk.Coins, error)
+	GetFeeAllowance(granter string, grantee string) (feegrant.FeeAllowanceI, error)
+	GetGasPrices() (sdk.DecCoins, error)
+
+	SimulateTx(tx authsigning.Tx) (uint64, error)
+	BroadcastTxAsync(tx authsigning.Tx) (*sdk.TxResponse, error)
+	BroadcastTxSync(tx authsigning.Tx) (*sdk.TxResponse, error)
+	BroadcastTxCommit(tx authsigning.Tx) (*sdk.TxResponse, error)
+	WaitForTx(ctx context.Context, hash string) (*sdk.TxResponse, error)
+}
+
+// Signer represents an object that is able to sign transactions on behalf of a single account.
+// Implementations can keep the private key in memory, read it from a keyring or delegate
+// the signing to a remote service or hardware device.
+type Signer interface {
+	// PubKey returns the public key of the account
+	PubKey() cryptotypes.PubKey
+
+	// Address returns the address of the account
+	Address() sdk.AccAddress
+
+	// Sign signs the given bytes that have been generated using the provided sign mode
+	Sign(signMode signing.SignMode, bytes []byte) ([]byte, error)
+}
+
+// ArmorExporter represents a Signer that is able to export its private key
+// in the ASCII-armored, passphrase-encrypted format used by the Cosmos SDK
+type ArmorExporter interface {
+	// ExportPrivKeyArmor returns the private key encrypted using the given passphrase
+	ExportPrivKeyArmor(passphrase string) (string, error)
+}
 
-	SimulateTx(tx signing.Tx) (uint64, error)
-	BroadcastTxAsync(tx signing.Tx) (*sdk.TxResponse, error)
-	BroadcastTxSync(tx signing.Tx) (*sdk.TxResponse, error)
-	BroadcastTxCommit(tx signing.Tx) (*sdk.TxResponse, error)
+// AddressParser represents an object that is able to parse Bech32 addresses (e.g. client.Client)
+type AddressParser interface {
+	ParseAddress(address string) (sdk.AccAddress, error)
 }
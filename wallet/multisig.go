@@ -0,0 +1,146 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+// MultisigTx contains a transaction that should be signed by the members of a multisig account.
+// Members sign it using Wallet.SignMultisigTx, and their signatures are then combined using CombineSignatures
+type MultisigTx struct {
+	// PubKey is the public key of the multisig account
+	PubKey *kmultisig.LegacyAminoPubKey
+
+	// Account is the multisig account that is going to sign the transaction
+	Account sdk.AccountI
+
+	// Builder contains the unsigned transaction
+	Builder sdkclient.TxBuilder
+
+	// SignerData contains the data that should be used by the members when signing the transaction
+	SignerData authsigning.SignerData
+
+	txConfig sdkclient.TxConfig
+}
+
+// BuildMultisigTx builds an unsigned transaction with the provided data that should be signed by the multisig account
+// having the given public key. Since multisig members can only sign using SIGN_MODE_LEGACY_AMINO_JSON,
+// the sign mode set inside the data is ignored
+func BuildMultisigTx(client Client, pubKey *kmultisig.LegacyAminoPubKey, data *types.TransactionData) (*MultisigTx, error) {
+	multisigData := *data
+	multisigData.SignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+	accounts, builder, signersData, err := buildUnsignedTx(client, []cryptotypes.PubKey{pubKey}, &multisigData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultisigTx{
+		PubKey:     pubKey,
+		Account:    accounts[0],
+		Builder:    builder,
+		SignerData: signersData[0],
+		txConfig:   client.GetTxConfig(),
+	}, nil
+}
+
+// getMemberSignerData returns the data that should be used by the member having the given index to sign the transaction
+func (tx *MultisigTx) getMemberSignerData(index int) authsigning.SignerData {
+	signerData := tx.SignerData
+	signerData.PubKey = tx.PubKey.GetPubKeys()[index]
+	return signerData
+}
+
+// getMemberIndex returns the index of the given public key inside the multisig members
+func (tx *MultisigTx) getMemberIndex(pubKey cryptotypes.PubKey) (int, error) {
+	for i, memberPubKey := range tx.PubKey.GetPubKeys() {
+		if memberPubKey.Equals(pubKey) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("signer %s is not a member of the multisig", sdk.AccAddress(pubKey.Address()))
+}
+
+// CombineSignatures combines the given partial signatures into a multisig signature and sets it inside the transaction,
+// returning the signed transaction that can be broadcasted using the client.
+// An error is returned if any of the signatures is invalid or if the multisig threshold has not been reached
+func (tx *MultisigTx) CombineSignatures(sigs ...signing.SignatureV2) (authsigning.Tx, error) {
+	multisigData := multisigtypes.NewMultisig(len(tx.PubKey.GetPubKeys()))
+	for _, sig := range sigs {
+		index, err := tx.getMemberIndex(sig.PubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		singleSigData, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok {
+			return nil, fmt.Errorf("invalid signature data type: %T", sig.Data)
+		}
+
+		// Make sure the partial signature is valid
+		signBytes, err := authsigning.GetSignBytesAdapter(
+			context.Background(),
+			tx.txConfig.SignModeHandler(),
+			singleSigData.SignMode,
+			tx.getMemberSignerData(index),
+			tx.Builder.GetTx(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if !sig.PubKey.VerifySignature(signBytes, singleSigData.Signature) {
+			return nil, fmt.Errorf("invalid signature from %s", sdk.AccAddress(sig.PubKey.Address()))
+		}
+
+		err = multisigtypes.AddSignatureV2(multisigData, sig, tx.PubKey.GetPubKeys())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signaturesCount := multisigData.BitArray.NumTrueBitsBefore(multisigData.BitArray.Count())
+	if signaturesCount < int(tx.PubKey.Threshold) {
+		return nil, fmt.Errorf("not enough signatures: threshold is %d, got %d", tx.PubKey.Threshold, signaturesCount)
+	}
+
+	err := tx.Builder.SetSignatures(signing.SignatureV2{
+		PubKey:   tx.PubKey,
+		Data:     multisigData,
+		Sequence: tx.Account.GetSequence(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Builder.GetTx(), nil
+}
+
+// SignMultisigTx produces the partial signature of the given multisig transaction using this wallet, which must
+// be one of the multisig members. The signature should be later combined using MultisigTx.CombineSignatures
+func (w *Wallet) SignMultisigTx(tx *MultisigTx) (signing.SignatureV2, error) {
+	index, err := tx.getMemberIndex(w.signer.PubKey())
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	return SignWithSigner(
+		context.Background(),
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		tx.getMemberSignerData(index),
+		tx.Builder,
+		w.signer,
+		tx.txConfig,
+		tx.Account.GetSequence(),
+	)
+}
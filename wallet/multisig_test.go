@@ -0,0 +1,85 @@
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+func TestMultisigTx(t *testing.T) {
+	member1, client := newTestWallet(t)
+	member2 := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), client)
+	member3 := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), client)
+	outsider := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), client)
+
+	pubKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
+		member1.Signer().PubKey(),
+		member2.Signer().PubKey(),
+		member3.Signer().PubKey(),
+	})
+
+	data := types.NewTransactionData(newTestMsgSend(member1)).WithGasAuto().WithFeeAuto()
+	multisigTx, err := wallet.BuildMultisigTx(client, pubKey, data)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(pubKey.Address()), multisigTx.Account.GetAddress())
+
+	// Make sure only members can sign
+	_, err = outsider.SignMultisigTx(multisigTx)
+	require.Error(t, err)
+
+	sig1, err := member1.SignMultisigTx(multisigTx)
+	require.NoError(t, err)
+
+	sig3, err := member3.SignMultisigTx(multisigTx)
+	require.NoError(t, err)
+
+	// Make sure the threshold is checked
+	_, err = multisigTx.CombineSignatures(sig1)
+	require.Error(t, err)
+
+	// Make sure invalid signatures are rejected
+	invalidSig := sig3
+	invalidSig.Data = &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		Signature: sig1.Data.(*signing.SingleSignatureData).Signature,
+	}
+	_, err = multisigTx.CombineSignatures(sig1, invalidSig)
+	require.Error(t, err)
+
+	signedTx, err := multisigTx.CombineSignatures(sig1, sig3)
+	require.NoError(t, err)
+
+	// Verify the multisig signature
+	sigs, err := signedTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+
+	multisigData, ok := sigs[0].Data.(*signing.MultiSignatureData)
+	require.True(t, ok)
+
+	err = pubKey.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
+		return authsigning.GetSignBytesAdapter(
+			context.Background(),
+			client.GetTxConfig().SignModeHandler(),
+			mode,
+			multisigTx.SignerData,
+			signedTx,
+		)
+	}, multisigData)
+	require.NoError(t, err)
+
+	// Make sure the transaction can be broadcasted using the client
+	_, err = client.BroadcastTxSync(signedTx)
+	require.NoError(t, err)
+	require.Len(t, client.BroadcastedTxs, 1)
+}
@@ -0,0 +1,116 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+// BuildMultiSignerTx creates a transaction with the provided data that is signed by all the given wallets.
+// Each message signer and the fee payer, if set, must be one of the given wallets, and each wallet must be one of
+// the transaction signers. The signatures are sorted based on the order in which the signers appear inside the
+// messages, with the fee payer being the last one if it does not sign any message
+func BuildMultiSignerTx(client Client, data *types.TransactionData, wallets ...*Wallet) ([]sdk.AccountI, sdkclient.TxBuilder, error) {
+	signers, err := getTxSigners(client, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sortedSigners, err := sortSigners(signers, wallets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, len(sortedSigners))
+	for i, signer := range sortedSigners {
+		pubKeys[i] = signer.PubKey()
+	}
+
+	accounts, builder, signersData, err := buildUnsignedTx(client, pubKeys, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = signTx(client, builder, data.GetSignMode(), sortedSigners, signersData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, builder, nil
+}
+
+// getTxSigners returns the addresses of the signers of the transaction having the given data, in the order in which
+// they should sign it. The fee payer, if set and not signing any message, is included as the last signer
+func getTxSigners(client Client, data *types.TransactionData) ([][]byte, error) {
+	builder := client.GetTxConfig().NewTxBuilder()
+	err := builder.SetMsgs(data.Messages...)
+	if err != nil {
+		return nil, err
+	}
+	if data.FeePayer != nil {
+		builder.SetFeePayer(data.FeePayer)
+	}
+
+	signers, err := builder.GetTx().GetSigners()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the transaction signers: %s", err)
+	}
+	return signers, nil
+}
+
+// sortSigners returns the signers of the given wallets, sorted based on the provided signers addresses.
+// An error is returned if any of the addresses has no wallet, or if any of the wallets is not among the signers
+func sortSigners(signers [][]byte, wallets []*Wallet) ([]Signer, error) {
+	sorted := make([]Signer, len(signers))
+	for i, signer := range signers {
+		for _, wallet := range wallets {
+			if bytes.Equal(signer, wallet.signer.Address()) {
+				sorted[i] = wallet.signer
+				break
+			}
+		}
+
+		if sorted[i] == nil {
+			return nil, fmt.Errorf("no wallet found for signer %s", sdk.AccAddress(signer))
+		}
+	}
+
+	if len(wallets) != len(signers) {
+		return nil, fmt.Errorf("wrong number of wallets: expected %d, got %d", len(signers), len(wallets))
+	}
+
+	return sorted, nil
+}
+
+// signTx signs the transaction contained inside the given builder with all the provided signers,
+// using the given signers data that must be sorted in the same way
+func signTx(client Client, builder sdkclient.TxBuilder, signMode signing.SignMode, signers []Signer, signersData []authsigning.SignerData) error {
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		sig, err := SignWithSigner(
+			// The context is only used to query the coins metadata when using SIGN_MODE_TEXTUAL
+			context.Background(),
+
+			signMode,
+			signersData[i],
+			builder,
+			signer,
+			client.GetTxConfig(),
+			signersData[i].Sequence,
+		)
+		if err != nil {
+			return fmt.Errorf("error while signing transaction with %s: %s", signersData[i].Address, err)
+		}
+		sigs[i] = sig
+	}
+
+	return builder.SetSignatures(sigs...)
+}
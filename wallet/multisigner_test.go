@@ -0,0 +1,85 @@
+package wallet_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+func TestBuildMultiSignerTx(t *testing.T) {
+	wallet1, client := newTestWallet(t)
+	wallet2 := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), client)
+	wallet3 := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), client)
+
+	client.Sequences[wallet1.AccAddress()] = 3
+	client.Sequences[wallet2.AccAddress()] = 7
+
+	msgs := []sdk.Msg{
+		newTestMsgSend(wallet2),
+		banktypes.NewMsgSend(
+			wallet1.Signer().Address(),
+			wallet2.Signer().Address(),
+			sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
+		),
+	}
+
+	// Missing signer
+	_, _, err := wallet.BuildMultiSignerTx(client, types.NewTransactionData(msgs...).WithGasAuto(), wallet1)
+	require.Error(t, err)
+
+	// Wallet that is not a signer
+	_, _, err = wallet.BuildMultiSignerTx(client, types.NewTransactionData(msgs...).WithGasAuto(), wallet1, wallet2, wallet3)
+	require.Error(t, err)
+
+	accounts, builder, err := wallet.BuildMultiSignerTx(client, types.NewTransactionData(msgs...).WithGasAuto().WithFeeAuto(), wallet1, wallet2)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	// Make sure the signatures are sorted based on the transaction signers
+	sigs, err := builder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+	require.True(t, wallet2.Signer().PubKey().Equals(sigs[0].PubKey))
+	require.Equal(t, uint64(7), sigs[0].Sequence)
+	require.True(t, wallet1.Signer().PubKey().Equals(sigs[1].PubKey))
+	require.Equal(t, uint64(3), sigs[1].Sequence)
+}
+
+func TestBuildMultiSignerTx_FeePayer(t *testing.T) {
+	user, client := newTestWallet(t)
+	payer := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), client)
+
+	data := types.NewTransactionData(newTestMsgSend(user)).
+		WithGasAuto().
+		WithFeeAuto().
+		WithFeePayer(payer.Signer().Address())
+
+	// The fee payer must sign the transaction as well
+	_, _, err := user.BuildTx(data)
+	require.Error(t, err)
+
+	_, _, err = wallet.BuildMultiSignerTx(client, data, user)
+	require.Error(t, err)
+
+	_, builder, err := wallet.BuildMultiSignerTx(client, data, payer, user)
+	require.NoError(t, err)
+	require.Equal(t, []byte(payer.Signer().Address()), builder.GetTx().FeePayer())
+
+	sigs, err := builder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+	require.True(t, user.Signer().PubKey().Equals(sigs[0].PubKey))
+	require.True(t, payer.Signer().PubKey().Equals(sigs[1].PubKey))
+
+	// A fee payer that is already signing the transaction does not require any other wallet
+	_, builder, err = user.BuildTx(data.WithFeePayer(user.Signer().Address()))
+	require.NoError(t, err)
+	requireValidSignature(t, client, user, builder.GetTx())
+}
@@ -0,0 +1,184 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+// TransactionDataBuilder represents a function that builds the data of a transaction that should be signed
+// by the given wallet. It is needed since the messages must contain the address of the wallet that signs them
+type TransactionDataBuilder func(wallet *Wallet) *types.TransactionData
+
+// TopUpConfig contains the configuration used to automatically top up the balances of the accounts of a WalletPool
+type TopUpConfig struct {
+	// Funder is the wallet used to send the tokens to the pool accounts
+	Funder *Wallet
+
+	// MinBalance is the minimum balance that each account should have before broadcasting a transaction
+	MinBalance sdk.Coins
+
+	// Amount is the amount of tokens that is sent to an account when its balance is below the minimum one
+	Amount sdk.Coins
+
+	// Timeout is the maximum amount of time to wait for a top up transaction to be included inside a block.
+	// When zero, DefaultTopUpTimeout is used
+	Timeout time.Duration
+}
+
+// DefaultTopUpTimeout is the default amount of time to wait for a top up transaction to be included inside a block
+const DefaultTopUpTimeout = time.Minute
+
+// WalletPool allows to broadcast transactions in parallel using multiple accounts,
+// so that the throughput is not limited by the sequence of a single account
+type WalletPool struct {
+	wallets []*Wallet
+	topUp   *TopUpConfig
+
+	mu sync.Mutex
+
+	// pending contains the number of transactions being broadcasted by each wallet
+	pending []int
+
+	// next is the index of the wallet that should be preferred when multiple wallets are equally busy
+	next int
+
+	// topUpLocks contains the locks used to avoid topping up the same account multiple times
+	topUpLocks []sync.Mutex
+}
+
+// NewWalletPool returns a new WalletPool instance containing the given wallets
+func NewWalletPool(wallets ...*Wallet) (*WalletPool, error) {
+	if len(wallets) == 0 {
+		return nil, fmt.Errorf("error while creating the wallet pool: no wallets provided")
+	}
+
+	return &WalletPool{
+		wallets:    wallets,
+		pending:    make([]int, len(wallets)),
+		topUpLocks: make([]sync.Mutex, len(wallets)),
+	}, nil
+}
+
+// WithTopUp enables the automatic top up of the accounts balances using the given configuration.
+// Before broadcasting a transaction, the balance of the chosen account is checked and, if it is below the
+// minimum one, the funder sends the configured amount of tokens to it and waits for the transfer to be included
+// inside a block
+func (p *WalletPool) WithTopUp(config TopUpConfig) *WalletPool {
+	p.topUp = &config
+	return p
+}
+
+// Wallets returns the wallets contained inside the pool
+func (p *WalletPool) Wallets() []*Wallet {
+	return p.wallets
+}
+
+// acquire returns the index of the least busy wallet, marking it as having one more pending transaction
+func (p *WalletPool) acquire() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	best := -1
+	for i := range p.wallets {
+		index := (p.next + i) % len(p.wallets)
+		if best == -1 || p.pending[index] < p.pending[best] {
+			best = index
+		}
+	}
+
+	p.pending[best]++
+	p.next = (best + 1) % len(p.wallets)
+	return best
+}
+
+// release marks the wallet having the given index as having one less pending transaction
+func (p *WalletPool) release(index int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending[index]--
+}
+
+// topUpIfNeeded sends the configured amount of tokens to the account of the wallet having the given index
+// if its balance is below the minimum one
+func (p *WalletPool) topUpIfNeeded(index int) error {
+	p.topUpLocks[index].Lock()
+	defer p.topUpLocks[index].Unlock()
+
+	address := p.wallets[index].AccAddress()
+	balance, err := p.wallets[index].client.GetBalances(address)
+	if err != nil {
+		return fmt.Errorf("error while getting the balance of account %s: %s", address, err)
+	}
+
+	if balance.IsAllGTE(p.topUp.MinBalance) {
+		return nil
+	}
+
+	// Build the message using the client prefix, since banktypes.NewMsgSend uses the global one
+	msg := &banktypes.MsgSend{
+		FromAddress: p.topUp.Funder.AccAddress(),
+		ToAddress:   address,
+		Amount:      p.topUp.Amount,
+	}
+	timeout := p.topUp.Timeout
+	if timeout == 0 {
+		timeout = DefaultTopUpTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	response, err := p.topUp.Funder.BroadcastTxAndWait(ctx, types.NewTransactionData(msg).WithGasAuto().WithFeeAuto())
+	if err != nil {
+		return fmt.Errorf("error while topping up account %s: %s", address, err)
+	}
+
+	if response.Code != 0 {
+		return fmt.Errorf("error while topping up account %s: %s", address, response.RawLog)
+	}
+
+	return nil
+}
+
+// getTransactionResponse builds a transaction using the least busy wallet and broadcasts it using the given method
+func (p *WalletPool) getTransactionResponse(
+	buildData TransactionDataBuilder, broadcast func(w *Wallet, data *types.TransactionData) (types.TransactionResponse, error),
+) (types.TransactionResponse, error) {
+	index := p.acquire()
+	defer p.release(index)
+
+	if p.topUp != nil {
+		err := p.topUpIfNeeded(index)
+		if err != nil {
+			return types.NewTransactionResponse(), err
+		}
+	}
+
+	wallet := p.wallets[index]
+	return broadcast(wallet, buildData(wallet))
+}
+
+// BroadcastTxAsync creates and signs a transaction using the least busy wallet,
+// then broadcasts it using the async method
+func (p *WalletPool) BroadcastTxAsync(buildData TransactionDataBuilder) (types.TransactionResponse, error) {
+	return p.getTransactionResponse(buildData, (*Wallet).BroadcastTxAsync)
+}
+
+// BroadcastTxSync creates and signs a transaction using the least busy wallet,
+// then broadcasts it using the sync method
+func (p *WalletPool) BroadcastTxSync(buildData TransactionDataBuilder) (types.TransactionResponse, error) {
+	return p.getTransactionResponse(buildData, (*Wallet).BroadcastTxSync)
+}
+
+// BroadcastTxCommit creates and signs a transaction using the least busy wallet,
+// then broadcasts it using the commit method
+func (p *WalletPool) BroadcastTxCommit(buildData TransactionDataBuilder) (types.TransactionResponse, error) {
+	return p.getTransactionResponse(buildData, (*Wallet).BroadcastTxCommit)
+}
@@ -0,0 +1,187 @@
+package wallet_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/testutils"
+	"github.com/riccardom/cosmos-go-wallet/types"
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+// newTestWalletPool returns a new WalletPool containing count wallets derived from the test mnemonic,
+// each one tracking its own sequence and using the given Bech32 prefix
+func newTestWalletPool(t *testing.T, bech32Prefix string, count uint32) (*wallet.WalletPool, *testutils.MockClient) {
+	t.Helper()
+
+	client := testutils.NewMockClient(testutils.MakeTestEncodingConfigWithPrefix(bech32Prefix).TxConfig, bech32Prefix)
+	client.CheckSequences = true
+
+	wallets, err := wallet.NewWalletsRange(&types.AccountConfig{
+		Mnemonic: testMnemonic,
+		HDPath:   testHDPath,
+	}, 0, count, client)
+	require.NoError(t, err)
+
+	for _, w := range wallets {
+		w.WithSequenceTracking()
+	}
+
+	pool, err := wallet.NewWalletPool(wallets...)
+	require.NoError(t, err)
+	return pool, client
+}
+
+// newTestTxData returns the data of a transaction containing a single MsgSend signed by the given wallet
+func newTestTxData(w *wallet.Wallet) *types.TransactionData {
+	return types.NewTransactionData(newTestMsgSend(w)).WithGasAuto().WithFeeAuto()
+}
+
+// countTxsBySigner returns the number of transactions that have been signed by each address
+func countTxsBySigner(t *testing.T, txs []signing.Tx) map[string]int {
+	t.Helper()
+
+	counts := map[string]int{}
+	for _, tx := range txs {
+		signers, err := tx.GetSigners()
+		require.NoError(t, err)
+		counts[sdk.AccAddress(signers[0]).String()]++
+	}
+	return counts
+}
+
+func TestNewWalletPool(t *testing.T) {
+	_, err := wallet.NewWalletPool()
+	require.Error(t, err)
+}
+
+func TestWalletPool_Broadcast(t *testing.T) {
+	pool, client := newTestWalletPool(t, "cosmos", 3)
+
+	// Sequential transactions should be spread across all the wallets
+	for i := 0; i < 6; i++ {
+		res, err := pool.BroadcastTxSync(newTestTxData)
+		require.NoError(t, err)
+		require.Zero(t, res.Code)
+	}
+
+	counts := countTxsBySigner(t, client.BroadcastedTxs)
+	require.Len(t, counts, 3)
+	for _, w := range pool.Wallets() {
+		require.Equal(t, 2, counts[w.AccAddress()])
+	}
+
+	// Concurrent transactions should all succeed
+	var wg sync.WaitGroup
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := pool.BroadcastTxCommit(newTestTxData)
+			assert.NoError(t, err)
+			assert.Zero(t, res.Code)
+		}()
+	}
+	wg.Wait()
+	require.Len(t, client.BroadcastedTxs, 36)
+}
+
+func TestWalletPool_WithTopUp(t *testing.T) {
+	for _, bech32Prefix := range []string{"cosmos", "desmos"} {
+		bech32Prefix := bech32Prefix
+		t.Run(bech32Prefix, func(t *testing.T) {
+			pool, client := newTestWalletPool(t, bech32Prefix, 1)
+			funder := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), client)
+
+			// Start with an account that has no tokens
+			client.Balances[pool.Wallets()[0].AccAddress()] = sdk.NewCoins()
+
+			// Move the tokens as if the transactions were executed
+			client.BroadcastFn = func(tx signing.Tx) (*sdk.TxResponse, error) {
+				for _, msg := range tx.GetMsgs() {
+					if send, ok := msg.(*banktypes.MsgSend); ok && send.FromAddress == funder.AccAddress() {
+						client.Balances[send.ToAddress] = client.Balances[send.ToAddress].Add(send.Amount...)
+					}
+				}
+
+				bz, err := client.TxConfig.TxEncoder()(tx)
+				if err != nil {
+					return nil, err
+				}
+				return &sdk.TxResponse{TxHash: fmt.Sprintf("%X", comettypes.Tx(bz).Hash())}, nil
+			}
+
+			pool = pool.WithTopUp(wallet.TopUpConfig{
+				Funder:     funder,
+				MinBalance: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10_000))),
+				Amount:     sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100_000))),
+				Timeout:    time.Second,
+			})
+
+			// Include the transactions inside a block periodically
+			done := make(chan struct{})
+			defer close(done)
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-time.After(5 * time.Millisecond):
+						client.Commit()
+					}
+				}
+			}()
+
+			for i := 0; i < 2; i++ {
+				res, err := pool.BroadcastTxSync(func(w *wallet.Wallet) *types.TransactionData {
+					msg := &banktypes.MsgSend{
+						FromAddress: w.AccAddress(),
+						ToAddress:   funder.AccAddress(),
+						Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
+					}
+					return types.NewTransactionData(msg).WithGasAuto().WithFeeAuto()
+				})
+				require.NoError(t, err)
+				require.Zero(t, res.Code)
+			}
+
+			// Only the first transaction should have required a top up
+			counts := countTxsBySigner(t, client.BroadcastedTxs)
+			require.Equal(t, 1, counts[funder.Signer().Address().String()])
+			require.Equal(t, 2, counts[pool.Wallets()[0].Signer().Address().String()])
+			require.Equal(t, "100000stake", client.Balances[pool.Wallets()[0].AccAddress()].String())
+		})
+	}
+}
+
+func TestWalletPool_WithTopUp_Timeout(t *testing.T) {
+	pool, client := newTestWalletPool(t, "cosmos", 1)
+	funder := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), client)
+	client.Balances[pool.Wallets()[0].AccAddress()] = sdk.NewCoins()
+
+	pool = pool.WithTopUp(wallet.TopUpConfig{
+		Funder:     funder,
+		MinBalance: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10_000))),
+		Amount:     sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100_000))),
+		Timeout:    20 * time.Millisecond,
+	})
+
+	// The top up transaction is never included inside a block
+	_, err := pool.BroadcastTxSync(newTestTxData)
+	require.Error(t, err)
+
+	counts := countTxsBySigner(t, client.BroadcastedTxs)
+	require.Equal(t, 1, counts[funder.Signer().Address().String()])
+	require.Zero(t, counts[pool.Wallets()[0].Signer().Address().String()])
+}
@@ -0,0 +1,186 @@
+package wallet
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+// sequenceTracker keeps track of the sequence of an account locally, so that multiple transactions can be
+// broadcasted one after the other without having to wait for the previous ones to be included inside a block
+type sequenceTracker struct {
+	mu sync.Mutex
+
+	// sequence is the sequence that should be used for the next transaction.
+	// When nil, it will be read from the chain before being used
+	sequence *uint64
+}
+
+// newSequenceTracker returns a new sequenceTracker instance
+func newSequenceTracker() *sequenceTracker {
+	return &sequenceTracker{}
+}
+
+// getSequence returns the sequence that should be used to sign the next transaction,
+// querying the chain using the given client if it is not known yet.
+// NOTE: The caller must hold the tracker lock
+func (t *sequenceTracker) getSequence(client Client, address string) (uint64, error) {
+	if t.sequence != nil {
+		return *t.sequence, nil
+	}
+
+	account, err := client.GetAccount(address)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting the account sequence: %s", err)
+	}
+
+	sequence := account.GetSequence()
+	t.sequence = &sequence
+	return sequence, nil
+}
+
+// update updates the tracked sequence based on the result of a transaction that has been
+// signed using the current sequence.
+// NOTE: The caller must hold the tracker lock
+func (t *sequenceTracker) update(response types.TransactionResponse, err error) {
+	switch {
+	case err != nil && response.Tx != nil:
+		// The transaction might have reached the chain or not, so we need to read the sequence again
+		t.reset()
+
+	case response.TxResponse == nil:
+		// The transaction has not been built, so the sequence has not been used
+
+	case isSequenceMismatch(response.TxResponse):
+		t.reset()
+
+	case response.Code == 0 || response.Height > 0:
+		// Transactions that failed inside a block still increment the sequence
+		*t.sequence++
+	}
+}
+
+// reset makes sure that the sequence is read again from the chain before signing the next transaction.
+// NOTE: The caller must hold the tracker lock
+func (t *sequenceTracker) reset() {
+	t.sequence = nil
+}
+
+// setSequence sets the sequence that should be used for the next transaction.
+// NOTE: The caller must hold the tracker lock
+func (t *sequenceTracker) setSequence(sequence uint64) {
+	t.sequence = &sequence
+}
+
+// isSequenceMismatch tells whether the given response contains the ErrWrongSequence error
+func isSequenceMismatch(response *sdk.TxResponse) bool {
+	return response.Codespace == sdkerrors.ErrWrongSequence.Codespace() &&
+		response.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+// sequenceMismatchRegex matches the log of the ErrWrongSequence error returned by the chain
+var sequenceMismatchRegex = regexp.MustCompile(`expected (\d+), got (\d+)`)
+
+// parseExpectedSequence returns the sequence expected by the chain that is contained inside the given log
+// of an ErrWrongSequence error. It returns false if the log does not contain the expected sequence
+func parseExpectedSequence(log string) (uint64, bool) {
+	matches := sequenceMismatchRegex.FindStringSubmatch(log)
+	if len(matches) != 3 {
+		return 0, false
+	}
+
+	sequence, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return sequence, true
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// WithSequenceTracking enables the local tracking of the account sequence. When enabled, the sequence is read from
+// the chain only once and then incremented locally after each transaction that is successfully broadcasted,
+// allowing to broadcast multiple transactions without having to wait for the previous ones to be included inside a
+// block. The sequence is read again from the chain when an account sequence mismatch error is returned.
+// Transactions that have an explicit sequence or that are signed offline are not tracked.
+// The tracked sequence is shared by all the wallets of the same account that use the same AccountsRegistry,
+// so either all or none of them should have the tracking enabled
+func (w *Wallet) WithSequenceTracking() *Wallet {
+	w.trackSequence = true
+	return w
+}
+
+// WithSequenceMismatchRetries sets the number of times that a transaction should be signed again and
+// broadcasted when the chain returns an account sequence mismatch error. Each retry uses the sequence
+// expected by the chain, as reported inside the raw log of the error
+func (w *Wallet) WithSequenceMismatchRetries(retries uint) *Wallet {
+	w.sequenceMismatchRetries = retries
+	return w
+}
+
+// retryWithExpectedSequence signs again the transaction having the provided data using the sequence expected
+// by the chain, and broadcasts it using the given method. It does so until the chain no longer returns an
+// account sequence mismatch error, or the maximum number of retries has been reached
+func (w *Wallet) retryWithExpectedSequence(
+	data *types.TransactionData, broadcast types.TxBroadcastMethod, response types.TransactionResponse, err error,
+) (types.TransactionResponse, error) {
+	for i := uint(0); i < w.sequenceMismatchRetries; i++ {
+		if err != nil || response.TxResponse == nil || !isSequenceMismatch(response.TxResponse) {
+			break
+		}
+
+		sequence, ok := parseExpectedSequence(response.RawLog)
+		if !ok {
+			break
+		}
+
+		if w.isSequenceTracked(data) {
+			tracker := w.account.sequenceTracker
+			tracker.mu.Lock()
+			tracker.setSequence(sequence)
+			tracker.mu.Unlock()
+
+			response, err = w.getTrackedTransactionResponse(data, broadcast)
+			continue
+		}
+
+		// Copy the data so that the caller's instance is not modified
+		retryData := *data
+		retryData.Sequence = &sequence
+		response, err = w.buildAndBroadcastTx(&retryData, broadcast)
+	}
+
+	return response, err
+}
+
+// isSequenceTracked tells whether the sequence of the transaction having the given data should be tracked locally
+func (w *Wallet) isSequenceTracked(data *types.TransactionData) bool {
+	return w.trackSequence && data.Sequence == nil && data.Offline == nil
+}
+
+// getTrackedTransactionResponse builds and broadcasts a transaction with the provided data,
+// using the sequence that is tracked locally
+func (w *Wallet) getTrackedTransactionResponse(data *types.TransactionData, broadcast types.TxBroadcastMethod) (types.TransactionResponse, error) {
+	tracker := w.account.sequenceTracker
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	sequence, err := tracker.getSequence(w.client, w.AccAddress())
+	if err != nil {
+		return types.NewTransactionResponse(), err
+	}
+
+	// Copy the data so that the caller's instance is not modified
+	trackedData := *data
+	trackedData.Sequence = &sequence
+
+	response, err := w.buildAndBroadcastTx(&trackedData, broadcast)
+	tracker.update(response, err)
+	return response, err
+}
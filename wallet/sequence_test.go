@@ -0,0 +1,161 @@
+package wallet_test
+
+import (
+	"sync"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+func TestWallet_WithoutSequenceTracking(t *testing.T) {
+	w, client := newTestWallet(t)
+	client.CheckSequences = true
+
+	res, err := w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.NoError(t, err)
+	require.Zero(t, res.Code)
+
+	// The first transaction has not been committed yet, so the same sequence is used again
+	res, err = w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), res.Code)
+}
+
+func TestWallet_WithSequenceTracking(t *testing.T) {
+	w, client := newTestWallet(t)
+	w = w.WithSequenceTracking()
+	client.CheckSequences = true
+	client.Sequences[w.AccAddress()] = 5
+
+	for i := 0; i < 3; i++ {
+		res, err := w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+		require.NoError(t, err)
+		require.Zero(t, res.Code)
+
+		sigs, err := res.Tx.GetSignaturesV2()
+		require.NoError(t, err)
+		require.Equal(t, uint64(5+i), sigs[0].Sequence)
+	}
+
+	// Concurrent broadcasts should never use the same sequence
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasAuto().WithFeeAuto())
+			assert.NoError(t, err)
+			assert.Zero(t, res.Code)
+		}()
+	}
+	wg.Wait()
+	require.Len(t, client.BroadcastedTxs, 13)
+
+	// Simulate another process using the same account
+	client.Commit()
+	client.Sequences[w.AccAddress()] = 100
+
+	res, err := w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), res.Code)
+
+	// The sequence should be read again from the chain after the mismatch
+	res, err = w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.NoError(t, err)
+	require.Zero(t, res.Code)
+
+	sigs, err := res.Tx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), sigs[0].Sequence)
+}
+
+func TestWallet_WithSequenceTracking_SameAccount(t *testing.T) {
+	registry := wallet.NewAccountsRegistry()
+	w1, client := newTestWallet(t)
+	w1 = w1.WithAccountsRegistry(registry).WithSequenceTracking()
+	w2 := wallet.NewWalletFromSigner(w1.Signer(), client).WithAccountsRegistry(registry).WithSequenceTracking()
+	client.CheckSequences = true
+	client.Sequences[w1.AccAddress()] = 5
+
+	// Wallets of the same account using the same registry should share the tracked sequence
+	for i := 0; i < 4; i++ {
+		w := w1
+		if i%2 == 1 {
+			w = w2
+		}
+
+		res, err := w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+		require.NoError(t, err)
+		require.Zero(t, res.Code)
+
+		sigs, err := res.Tx.GetSignaturesV2()
+		require.NoError(t, err)
+		require.Equal(t, uint64(5+i), sigs[0].Sequence)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		w := w1
+		if i%2 == 1 {
+			w = w2
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+			assert.NoError(t, err)
+			assert.Zero(t, res.Code)
+		}()
+	}
+	wg.Wait()
+	require.Len(t, client.BroadcastedTxs, 14)
+}
+
+func TestWallet_WithSequenceMismatchRetries(t *testing.T) {
+	w, client := newTestWallet(t)
+	client.CheckSequences = true
+
+	// Broadcast a transaction that is not committed, as if the wallet had been restarted afterwards
+	res, err := w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.NoError(t, err)
+	require.Zero(t, res.Code)
+
+	w = w.WithSequenceMismatchRetries(1)
+	data := types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000)
+	res, err = w.BroadcastTxSync(data)
+	require.NoError(t, err)
+	require.Zero(t, res.Code)
+	require.Nil(t, data.Sequence)
+
+	sigs, err := res.Tx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), sigs[0].Sequence)
+
+	// Retries should keep the tracked sequence updated
+	w = w.WithSequenceTracking()
+	res, err = w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.NoError(t, err)
+	require.Zero(t, res.Code)
+
+	// Simulate another process using the same account
+	client.Commit()
+	client.Sequences[w.AccAddress()] = 10
+
+	res, err = w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.NoError(t, err)
+	require.Zero(t, res.Code)
+
+	res, err = w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.NoError(t, err)
+	require.Zero(t, res.Code)
+
+	sigs, err = res.Tx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), sigs[0].Sequence)
+}
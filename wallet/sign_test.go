@@ -0,0 +1,252 @@
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1"
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1/injective"
+	"github.com/riccardom/cosmos-go-wallet/testutils"
+	"github.com/riccardom/cosmos-go-wallet/types"
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+// newTestWallet returns a new Wallet using a random key and a mock client
+func newTestWallet(t *testing.T) (*wallet.Wallet, *testutils.MockClient) {
+	t.Helper()
+
+	encodingCfg := testutils.MakeTestEncodingConfig()
+	client := testutils.NewMockClient(encodingCfg.TxConfig, "cosmos")
+	return wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), client), client
+}
+
+// newTestMsgSend returns a new MsgSend having the given wallet as sender
+func newTestMsgSend(w *wallet.Wallet) sdk.Msg {
+	return banktypes.NewMsgSend(
+		w.Signer().Address(),
+		secp256k1.GenPrivKey().PubKey().Address().Bytes(),
+		sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
+	)
+}
+
+// requireValidSignature checks that the only signature of the given transaction is valid
+func requireValidSignature(t *testing.T, client *testutils.MockClient, w *wallet.Wallet, tx authsigning.Tx) {
+	t.Helper()
+
+	sigs, err := tx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+
+	sigData, ok := sigs[0].Data.(*signing.SingleSignatureData)
+	require.True(t, ok)
+
+	signBytes, err := authsigning.GetSignBytesAdapter(
+		context.Background(),
+		client.GetTxConfig().SignModeHandler(),
+		sigData.SignMode,
+		authsigning.SignerData{
+			Address:       w.AccAddress(),
+			ChainID:       client.ChainID,
+			AccountNumber: client.AccountNumber,
+			Sequence:      sigs[0].Sequence,
+			PubKey:        w.Signer().PubKey(),
+		},
+		tx,
+	)
+	require.NoError(t, err)
+	require.True(t, w.Signer().PubKey().VerifySignature(signBytes, sigData.Signature))
+}
+
+func TestWallet_BuildTx_SignMode(t *testing.T) {
+	testCases := []struct {
+		name         string
+		signMode     signing.SignMode
+		expectedMode signing.SignMode
+	}{
+		{
+			name:         "unspecified sign mode uses direct",
+			signMode:     signing.SignMode_SIGN_MODE_UNSPECIFIED,
+			expectedMode: signing.SignMode_SIGN_MODE_DIRECT,
+		},
+		{
+			name:         "amino json sign mode is used properly",
+			signMode:     signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			expectedMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w, client := newTestWallet(t)
+
+			data := types.NewTransactionData(newTestMsgSend(w)).
+				WithGasAuto().
+				WithFeeAuto().
+				WithMemo("Custom memo").
+				WithSignMode(tc.signMode)
+
+			_, builder, err := w.BuildTx(data)
+			require.NoError(t, err)
+
+			tx := builder.GetTx()
+			sigs, err := tx.GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			require.Equal(t, tc.expectedMode, sigs[0].Data.(*signing.SingleSignatureData).SignMode)
+
+			requireValidSignature(t, client, w, tx)
+		})
+	}
+}
+
+func TestWallet_BuildTx_EthSecp256k1(t *testing.T) {
+	testCases := []struct {
+		name       string
+		privKey    cryptotypes.PrivKey
+		signMode   signing.SignMode
+		pubKeyType string
+	}{
+		{
+			name:       "Ethermint key using direct sign mode",
+			privKey:    ethsecp256k1.GenPrivKey(),
+			signMode:   signing.SignMode_SIGN_MODE_DIRECT,
+			pubKeyType: "/ethermint.crypto.v1.ethsecp256k1.PubKey",
+		},
+		{
+			name:       "Ethermint key using amino json sign mode",
+			privKey:    ethsecp256k1.GenPrivKey(),
+			signMode:   signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			pubKeyType: "/ethermint.crypto.v1.ethsecp256k1.PubKey",
+		},
+		{
+			name:       "Injective key using direct sign mode",
+			privKey:    injective.GenPrivKey(),
+			signMode:   signing.SignMode_SIGN_MODE_DIRECT,
+			pubKeyType: "/injective.crypto.v1beta1.ethsecp256k1.PubKey",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			client := testutils.NewMockClient(testutils.MakeTestEncodingConfig().TxConfig, "cosmos")
+			w := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(tc.privKey), client)
+
+			data := types.NewTransactionData(newTestMsgSend(w)).
+				WithGasAuto().
+				WithFeeAuto().
+				WithSignMode(tc.signMode)
+
+			_, builder, err := w.BuildTx(data)
+			require.NoError(t, err)
+
+			// Make sure the transaction can be decoded as it would be by the chain
+			txBz, err := client.GetTxConfig().TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+
+			decoded, err := client.GetTxConfig().TxDecoder()(txBz)
+			require.NoError(t, err)
+
+			tx, ok := decoded.(authsigning.Tx)
+			require.True(t, ok)
+
+			sigs, err := tx.GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			require.True(t, w.Signer().PubKey().Equals(sigs[0].PubKey))
+
+			anyPubKey, err := codectypes.NewAnyWithValue(sigs[0].PubKey)
+			require.NoError(t, err)
+			require.Equal(t, tc.pubKeyType, anyPubKey.TypeUrl)
+
+			requireValidSignature(t, client, w, tx)
+		})
+	}
+}
+
+func TestWallet_GetTextualSignDoc(t *testing.T) {
+	w, client := newTestWallet(t)
+
+	// Enable SIGN_MODE_TEXTUAL without any coin metadata
+	encodingCfg := testutils.MakeTestEncodingConfig()
+	txConfig, err := authtx.NewTxConfigWithOptions(encodingCfg.Codec, authtx.ConfigOptions{
+		EnabledSignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_TEXTUAL},
+		TextualCoinMetadataQueryFn: func(_ context.Context, _ string) (*bankv1beta1.Metadata, error) {
+			return nil, nil
+		},
+	})
+	require.NoError(t, err)
+	client.TxConfig = txConfig
+
+	data := types.NewTransactionData(newTestMsgSend(w)).
+		WithGasLimit(200_000).
+		WithFeeAuto().
+		WithMemo("Custom memo")
+
+	screens, err := w.GetTextualSignDoc(data)
+	require.NoError(t, err)
+	require.NotEmpty(t, screens)
+	require.Equal(t, "Chain id", screens[0].Title)
+	require.Equal(t, client.ChainID, screens[0].Content)
+
+	var hasMemo bool
+	for _, screen := range screens {
+		if screen.Title == "Memo" {
+			require.Equal(t, "Custom memo", screen.Content)
+			hasMemo = true
+		}
+	}
+	require.True(t, hasMemo)
+
+	// Make sure the transaction can be signed using SIGN_MODE_TEXTUAL
+	_, builder, err := w.BuildTx(data.WithSignMode(signing.SignMode_SIGN_MODE_TEXTUAL))
+	require.NoError(t, err)
+	requireValidSignature(t, client, w, builder.GetTx())
+}
+
+func TestWallet_BuildTx_Offline(t *testing.T) {
+	w, client := newTestWallet(t)
+	client.Offline = true
+
+	// Make sure the gas cannot be simulated
+	data := types.NewTransactionData(newTestMsgSend(w)).
+		WithGasAuto().
+		WithOfflineSignerData("offline-chain", 10, 5)
+	_, _, err := w.BuildTx(data)
+	require.Error(t, err)
+
+	// Make sure the fees cannot be computed
+	data = types.NewTransactionData(newTestMsgSend(w)).
+		WithGasLimit(200_000).
+		WithFeeAuto().
+		WithOfflineSignerData("offline-chain", 10, 5)
+	_, _, err = w.BuildTx(data)
+	require.Error(t, err)
+
+	// Make sure the transaction is signed without accessing the chain
+	data = types.NewTransactionData(newTestMsgSend(w)).
+		WithGasLimit(200_000).
+		WithFeeAmount(sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(2_000)))).
+		WithOfflineSignerData("offline-chain", 10, 5)
+	account, builder, err := w.BuildTx(data)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), account.GetAccountNumber())
+	require.Equal(t, uint64(5), account.GetSequence())
+
+	client.ChainID = "offline-chain"
+	client.AccountNumber = 10
+	requireValidSignature(t, client, w, builder.GetTx())
+}
@@ -0,0 +1,144 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	_ Signer = &PrivKeySigner{}
+	_ Signer = &KeyringSigner{}
+
+	_ ArmorExporter = &PrivKeySigner{}
+	_ ArmorExporter = &KeyringSigner{}
+)
+
+// PrivKeySigner represents a Signer that keeps the private key in memory
+type PrivKeySigner struct {
+	privKey cryptotypes.PrivKey
+}
+
+// NewPrivKeySigner returns a new PrivKeySigner instance
+func NewPrivKeySigner(privKey cryptotypes.PrivKey) *PrivKeySigner {
+	return &PrivKeySigner{
+		privKey: privKey,
+	}
+}
+
+// PubKey implements Signer
+func (s *PrivKeySigner) PubKey() cryptotypes.PubKey {
+	return s.privKey.PubKey()
+}
+
+// Address implements Signer
+func (s *PrivKeySigner) Address() sdk.AccAddress {
+	return sdk.AccAddress(s.privKey.PubKey().Address())
+}
+
+// Sign implements Signer
+func (s *PrivKeySigner) Sign(_ signing.SignMode, bytes []byte) ([]byte, error) {
+	return s.privKey.Sign(bytes)
+}
+
+// ExportPrivKeyArmor implements ArmorExporter
+func (s *PrivKeySigner) ExportPrivKeyArmor(passphrase string) (string, error) {
+	return crypto.EncryptArmorPrivKey(s.privKey, passphrase, s.privKey.Type()), nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// KeyringSigner represents a Signer that reads the key from a Cosmos SDK keyring
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	keyName string
+	pubKey  cryptotypes.PubKey
+}
+
+// NewKeyringSigner returns a new KeyringSigner instance that uses the key having the given name
+func NewKeyringSigner(kr keyring.Keyring, keyName string) (*KeyringSigner, error) {
+	record, err := kr.Key(keyName)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading key %s from keyring: %s", keyName, err)
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting public key of key %s: %s", keyName, err)
+	}
+
+	return &KeyringSigner{
+		keyring: kr,
+		keyName: keyName,
+		pubKey:  pubKey,
+	}, nil
+}
+
+// PubKey implements Signer
+func (s *KeyringSigner) PubKey() cryptotypes.PubKey {
+	return s.pubKey
+}
+
+// Address implements Signer
+func (s *KeyringSigner) Address() sdk.AccAddress {
+	return sdk.AccAddress(s.pubKey.Address())
+}
+
+// Sign implements Signer
+func (s *KeyringSigner) Sign(signMode signing.SignMode, bytes []byte) ([]byte, error) {
+	signature, _, err := s.keyring.Sign(s.keyName, bytes, signMode)
+	return signature, err
+}
+
+// ExportPrivKeyArmor implements ArmorExporter
+func (s *KeyringSigner) ExportPrivKeyArmor(passphrase string) (string, error) {
+	return s.keyring.ExportPrivKeyArmor(s.keyName, passphrase)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SignWithSigner signs the transaction contained inside the given builder using the provided signer.
+// This works the same way as tx.SignWithPrivKey, but delegates the signature to the Signer instead
+// of requiring the private key to be available.
+func SignWithSigner(
+	ctx context.Context,
+	signMode signing.SignMode, signerData authsigning.SignerData,
+	txBuilder sdkclient.TxBuilder, signer Signer, txConfig sdkclient.TxConfig,
+	accSeq uint64,
+) (signing.SignatureV2, error) {
+	var sigV2 signing.SignatureV2
+
+	// Generate the bytes to be signed
+	signBytes, err := authsigning.GetSignBytesAdapter(
+		ctx, txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return sigV2, err
+	}
+
+	// Sign those bytes
+	signature, err := signer.Sign(signMode, signBytes)
+	if err != nil {
+		return sigV2, err
+	}
+
+	// Construct the SignatureV2 struct
+	sigData := signing.SingleSignatureData{
+		SignMode:  signMode,
+		Signature: signature,
+	}
+
+	sigV2 = signing.SignatureV2{
+		PubKey:   signer.PubKey(),
+		Data:     &sigData,
+		Sequence: accSeq,
+	}
+
+	return sigV2, nil
+}
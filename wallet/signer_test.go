@@ -0,0 +1,100 @@
+package wallet_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1"
+	"github.com/riccardom/cosmos-go-wallet/crypto/ethsecp256k1/injective"
+	"github.com/riccardom/cosmos-go-wallet/testutils"
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+const (
+	testMnemonic = "forward service profit benefit punch catch fan chief jealous steel harvest column spell rude warm home melody hat broccoli pulse say garlic you firm"
+	testHDPath   = "m/44'/852'/0'/0/0"
+)
+
+func TestPrivKeySigner(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	signer := wallet.NewPrivKeySigner(privKey)
+
+	require.True(t, privKey.PubKey().Equals(signer.PubKey()))
+	require.Equal(t, sdk.AccAddress(privKey.PubKey().Address()), signer.Address())
+
+	msg := []byte("message to be signed")
+	signature, err := signer.Sign(signing.SignMode_SIGN_MODE_DIRECT, msg)
+	require.NoError(t, err)
+	require.True(t, signer.PubKey().VerifySignature(msg, signature))
+}
+
+func TestKeyringSigner(t *testing.T) {
+	encodingCfg := testutils.MakeTestEncodingConfig()
+	kr := keyring.NewInMemory(encodingCfg.Codec)
+
+	_, err := kr.NewAccount("test", testMnemonic, "", testHDPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	_, err = wallet.NewKeyringSigner(kr, "non-existing")
+	require.Error(t, err)
+
+	signer, err := wallet.NewKeyringSigner(kr, "test")
+	require.NoError(t, err)
+
+	// Make sure the keyring signer matches the one derived from the mnemonic
+	derivedPriv, err := hd.Secp256k1.Derive()(testMnemonic, "", testHDPath)
+	require.NoError(t, err)
+	privKeySigner := wallet.NewPrivKeySigner(hd.Secp256k1.Generate()(derivedPriv))
+
+	require.True(t, privKeySigner.PubKey().Equals(signer.PubKey()))
+	require.Equal(t, privKeySigner.Address(), signer.Address())
+
+	msg := []byte("message to be signed")
+	signature, err := signer.Sign(signing.SignMode_SIGN_MODE_DIRECT, msg)
+	require.NoError(t, err)
+	require.True(t, privKeySigner.PubKey().VerifySignature(msg, signature))
+}
+
+func TestWallet_ExportPrivKeyArmor(t *testing.T) {
+	testCases := []struct {
+		name    string
+		privKey cryptotypes.PrivKey
+	}{
+		{
+			name:    "secp256k1 key is exported and imported properly",
+			privKey: secp256k1.GenPrivKey(),
+		},
+		{
+			name:    "eth_secp256k1 key is exported and imported properly",
+			privKey: ethsecp256k1.GenPrivKey(),
+		},
+		{
+			name:    "Injective eth_secp256k1 key is exported and imported properly",
+			privKey: injective.GenPrivKey(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			original := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(tc.privKey), nil)
+
+			armor, err := original.ExportPrivKeyArmor("passphrase")
+			require.NoError(t, err)
+
+			_, err = wallet.NewWalletFromArmor(armor, "wrong passphrase", nil)
+			require.Error(t, err)
+
+			imported, err := wallet.NewWalletFromArmor(armor, "passphrase", nil)
+			require.NoError(t, err)
+			require.True(t, original.Signer().PubKey().Equals(imported.Signer().PubKey()))
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package wallet
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/x/tx/signing/textual"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+// GetTextualSignDoc builds a transaction with the provided data and returns the screens of its
+// SIGN_MODE_TEXTUAL sign document, so that they can be reviewed before signing the transaction.
+// The client TxConfig must have SIGN_MODE_TEXTUAL enabled.
+func (w *Wallet) GetTextualSignDoc(data *types.TransactionData) ([]textual.Screen, error) {
+	textualData := *data
+	textualData.SignMode = signing.SignMode_SIGN_MODE_TEXTUAL
+
+	_, builder, signerData, err := w.buildUnsignedTx(&textualData)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := authsigning.GetSignBytesAdapter(
+		context.Background(),
+		w.client.GetTxConfig().SignModeHandler(),
+		signing.SignMode_SIGN_MODE_TEXTUAL,
+		signerData,
+		builder.GetTx(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting textual sign bytes: %s", err)
+	}
+
+	return DecodeTextualSignDoc(signBytes)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+const (
+	cborMajorUint  = 0
+	cborMajorText  = 3
+	cborMajorArray = 4
+	cborMajorMap   = 5
+	cborMajorOther = 7
+
+	cborFalse = 20
+	cborTrue  = 21
+
+	textualScreensKey = 1
+	textualTitleKey   = 1
+	textualContentKey = 2
+	textualIndentKey  = 3
+	textualExpertKey  = 4
+)
+
+// DecodeTextualSignDoc decodes the given SIGN_MODE_TEXTUAL sign bytes into the screens they represent.
+// The sign bytes are the CBOR encoding of a map containing the list of screens, as described inside ADR-050
+func DecodeTextualSignDoc(bz []byte) ([]textual.Screen, error) {
+	decoder := &cborDecoder{bz: bz}
+
+	entries, err := decoder.readExpected(cborMajorMap)
+	if err != nil {
+		return nil, err
+	}
+
+	var screens []textual.Screen
+	for i := uint64(0); i < entries; i++ {
+		key, err := decoder.readExpected(cborMajorUint)
+		if err != nil {
+			return nil, err
+		}
+		if key != textualScreensKey {
+			return nil, fmt.Errorf("unexpected sign doc key: %d", key)
+		}
+
+		length, err := decoder.readExpected(cborMajorArray)
+		if err != nil {
+			return nil, err
+		}
+
+		// Each screen takes at least one byte, so avoid allocating more screens than the remaining bytes
+		if length > uint64(len(bz)-decoder.pos) {
+			return nil, fmt.Errorf("unexpected end of CBOR data")
+		}
+
+		screens = make([]textual.Screen, length)
+		for j := range screens {
+			screens[j], err = decoder.readScreen()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if decoder.pos != len(bz) {
+		return nil, fmt.Errorf("unexpected trailing bytes in sign doc")
+	}
+
+	return screens, nil
+}
+
+// cborDecoder allows to decode the subset of CBOR used by SIGN_MODE_TEXTUAL sign documents
+type cborDecoder struct {
+	bz  []byte
+	pos int
+}
+
+// readHeader reads the header of the next CBOR data item, returning its major type and argument
+func (d *cborDecoder) readHeader() (major byte, arg uint64, err error) {
+	if d.pos >= len(d.bz) {
+		return 0, 0, fmt.Errorf("unexpected end of CBOR data")
+	}
+
+	header := d.bz[d.pos]
+	d.pos++
+
+	major, info := header>>5, header&0x1f
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, fmt.Errorf("unsupported CBOR additional info: %d", info)
+	}
+
+	if d.pos+size > len(d.bz) {
+		return 0, 0, fmt.Errorf("unexpected end of CBOR data")
+	}
+
+	buf := make([]byte, 8)
+	copy(buf[8-size:], d.bz[d.pos:d.pos+size])
+	d.pos += size
+	return major, binary.BigEndian.Uint64(buf), nil
+}
+
+// readExpected reads the header of the next CBOR data item, making sure it has the given major type
+func (d *cborDecoder) readExpected(expectedMajor byte) (uint64, error) {
+	major, arg, err := d.readHeader()
+	if err != nil {
+		return 0, err
+	}
+	if major != expectedMajor {
+		return 0, fmt.Errorf("unexpected CBOR major type: expected %d, got %d", expectedMajor, major)
+	}
+	return arg, nil
+}
+
+// readText reads the next CBOR text string
+func (d *cborDecoder) readText() (string, error) {
+	length, err := d.readExpected(cborMajorText)
+	if err != nil {
+		return "", err
+	}
+
+	if length > uint64(len(d.bz)-d.pos) {
+		return "", fmt.Errorf("unexpected end of CBOR data")
+	}
+
+	text := string(d.bz[d.pos : d.pos+int(length)])
+	d.pos += int(length)
+	return text, nil
+}
+
+// readScreen reads the next screen of a SIGN_MODE_TEXTUAL sign document
+func (d *cborDecoder) readScreen() (textual.Screen, error) {
+	var screen textual.Screen
+
+	entries, err := d.readExpected(cborMajorMap)
+	if err != nil {
+		return screen, err
+	}
+
+	for i := uint64(0); i < entries; i++ {
+		key, err := d.readExpected(cborMajorUint)
+		if err != nil {
+			return screen, err
+		}
+
+		switch key {
+		case textualTitleKey:
+			screen.Title, err = d.readText()
+
+		case textualContentKey:
+			screen.Content, err = d.readText()
+
+		case textualIndentKey:
+			var indent uint64
+			indent, err = d.readExpected(cborMajorUint)
+			screen.Indent = int(indent)
+
+		case textualExpertKey:
+			var value uint64
+			value, err = d.readExpected(cborMajorOther)
+			if err == nil && value != cborTrue && value != cborFalse {
+				err = fmt.Errorf("invalid CBOR boolean value: %d", value)
+			}
+			screen.Expert = value == cborTrue
+
+		default:
+			err = fmt.Errorf("unexpected screen key: %d", key)
+		}
+
+		if err != nil {
+			return screen, err
+		}
+	}
+
+	return screen, nil
+}
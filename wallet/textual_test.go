@@ -0,0 +1,134 @@
+package wallet_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/tx/signing/textual"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/wallet"
+)
+
+func TestDecodeTextualSignDoc(t *testing.T) {
+	// {1: [{1: "Chain", 2: "cosmos", 3: 1, 4: true}]}
+	validDoc := []byte{
+		0xa1, 0x01, 0x81, 0xa4,
+		0x01, 0x65, 'C', 'h', 'a', 'i', 'n',
+		0x02, 0x66, 'c', 'o', 's', 'm', 'o', 's',
+		0x03, 0x01,
+		0x04, 0xf5,
+	}
+
+	testCases := []struct {
+		name      string
+		bz        []byte
+		shouldErr bool
+		expected  []textual.Screen
+	}{
+		{
+			name:      "valid sign doc returns no error",
+			bz:        validDoc,
+			shouldErr: false,
+			expected:  []textual.Screen{{Title: "Chain", Content: "cosmos", Indent: 1, Expert: true}},
+		},
+		{
+			name:      "valid sign doc using multi-byte lengths returns no error",
+			bz:        []byte{0xa1, 0x01, 0x98, 0x01, 0xa1, 0x02, 0x79, 0x00, 0x02, 'o', 'k'},
+			shouldErr: false,
+			expected:  []textual.Screen{{Content: "ok"}},
+		},
+		{
+			name:      "empty data returns error",
+			bz:        []byte{},
+			shouldErr: true,
+		},
+		{
+			name:      "truncated header argument returns error",
+			bz:        []byte{0xb9, 0x00},
+			shouldErr: true,
+		},
+		{
+			name:      "truncated screens list returns error",
+			bz:        []byte{0xa1, 0x01, 0x82, 0xa0},
+			shouldErr: true,
+		},
+		{
+			name:      "truncated text returns error",
+			bz:        []byte{0xa1, 0x01, 0x81, 0xa1, 0x01, 0x65, 'C', 'h'},
+			shouldErr: true,
+		},
+		{
+			name:      "huge screens list length returns error",
+			bz:        []byte{0xa1, 0x01, 0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			shouldErr: true,
+		},
+		{
+			name:      "huge text length returns error",
+			bz:        []byte{0xa1, 0x01, 0x81, 0xa1, 0x01, 0x7b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			shouldErr: true,
+		},
+		{
+			name:      "unsupported additional info returns error",
+			bz:        []byte{0xbf, 0x01, 0x80, 0xff},
+			shouldErr: true,
+		},
+		{
+			name:      "wrong sign doc major type returns error",
+			bz:        []byte{0x81, 0x01},
+			shouldErr: true,
+		},
+		{
+			name:      "wrong screens list major type returns error",
+			bz:        []byte{0xa1, 0x01, 0xa0},
+			shouldErr: true,
+		},
+		{
+			name:      "wrong screen major type returns error",
+			bz:        []byte{0xa1, 0x01, 0x81, 0x80},
+			shouldErr: true,
+		},
+		{
+			name:      "wrong title major type returns error",
+			bz:        []byte{0xa1, 0x01, 0x81, 0xa1, 0x01, 0x01},
+			shouldErr: true,
+		},
+		{
+			name:      "wrong indent major type returns error",
+			bz:        []byte{0xa1, 0x01, 0x81, 0xa1, 0x03, 0x61, '1'},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid expert value returns error",
+			bz:        []byte{0xa1, 0x01, 0x81, 0xa1, 0x04, 0xf6},
+			shouldErr: true,
+		},
+		{
+			name:      "unknown sign doc key returns error",
+			bz:        []byte{0xa1, 0x02, 0x80},
+			shouldErr: true,
+		},
+		{
+			name:      "unknown screen key returns error",
+			bz:        []byte{0xa1, 0x01, 0x81, 0xa1, 0x05, 0x01},
+			shouldErr: true,
+		},
+		{
+			name:      "trailing bytes return error",
+			bz:        append(append([]byte{}, validDoc...), 0x00),
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			screens, err := wallet.DecodeTextualSignDoc(tc.bz)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, screens)
+			}
+		})
+	}
+}
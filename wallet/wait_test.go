@@ -0,0 +1,92 @@
+package wallet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	comettypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/riccardom/cosmos-go-wallet/types"
+)
+
+func TestWallet_BroadcastTxAndWait(t *testing.T) {
+	w, client := newTestWallet(t)
+
+	// Include the transaction inside a block after a while
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		client.Commit()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := w.BroadcastTxAndWait(ctx, types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Height)
+	require.NotEmpty(t, res.TxHash)
+}
+
+func TestWallet_BroadcastTxAndWait_Timeout(t *testing.T) {
+	w, client := newTestWallet(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	res, err := w.BroadcastTxAndWait(ctx, types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+	require.Error(t, err)
+	require.Len(t, client.BroadcastedTxs, 1)
+
+	// The response of the sync broadcast should still be returned
+	require.NotNil(t, res.TxResponse)
+	require.Zero(t, res.Height)
+}
+
+func TestWallet_BroadcastTxAndWait_ReleasesLock(t *testing.T) {
+	w, client := newTestWallet(t)
+	w = w.WithSequenceTracking()
+
+	// Signal when the first transaction has been broadcasted
+	broadcasted := make(chan struct{}, 2)
+	client.BroadcastFn = func(tx signing.Tx) (*sdk.TxResponse, error) {
+		broadcasted <- struct{}{}
+
+		bz, err := client.TxConfig.TxEncoder()(tx)
+		if err != nil {
+			return nil, err
+		}
+		return &sdk.TxResponse{TxHash: fmt.Sprintf("%X", comettypes.Tx(bz).Hash())}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	waitErr := make(chan error, 1)
+	go func() {
+		_, err := w.BroadcastTxAndWait(ctx, types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+		waitErr <- err
+	}()
+	<-broadcasted
+
+	// Another transaction should be broadcasted while the first one is waiting to be included
+	syncErr := make(chan error, 1)
+	go func() {
+		_, err := w.BroadcastTxSync(types.NewTransactionData(newTestMsgSend(w)).WithGasLimit(200_000))
+		syncErr <- err
+	}()
+
+	select {
+	case err := <-syncErr:
+		require.NoError(t, err)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("transaction not broadcasted while waiting for the previous one")
+	}
+
+	client.Commit()
+	require.NoError(t, <-waitErr)
+}
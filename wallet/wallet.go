@@ -3,44 +3,144 @@ package wallet
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/cosmos/cosmos-sdk/crypto/hd"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
-	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
 	"github.com/riccardom/cosmos-go-wallet/types"
 )
 
-// Wallet represents a Cosmos wallet that should be used to create and send transactions to the chain
+// Wallet represents a Cosmos wallet that should be used to create and send transactions to the chain.
+// A Wallet is safe for concurrent use once it has been configured: its transactions are signed and broadcasted
+// one at a time, so that they never use the same sequence. A single Wallet should be shared for each account,
+// unless all the wallets of the same account use the same AccountsRegistry
 type Wallet struct {
-	privKey cryptotypes.PrivKey
-	client  Client
+	signer Signer
+	client Client
+
+	// account contains the state of the account, which can be shared with other wallets using an AccountsRegistry
+	account *accountState
+
+	// trackSequence tells whether the account sequence should be tracked locally
+	trackSequence bool
+
+	// sequenceMismatchRetries is the number of times a transaction is signed again
+	// when the chain returns an account sequence mismatch error
+	sequenceMismatchRetries uint
 }
 
 // NewWallet allows to build a new Wallet instance
 func NewWallet(accountCfg *types.AccountConfig, client Client) (*Wallet, error) {
-	// Get the private types
-	algo := hd.Secp256k1
-	derivedPriv, err := algo.Derive()(accountCfg.Mnemonic, "", accountCfg.HDPath)
+	// Get the private key
+	privKey, err := types.DerivePrivKey(accountCfg)
 	if err != nil {
 		return nil, err
 	}
 
+	return NewWalletFromSigner(NewPrivKeySigner(privKey), client), nil
+}
+
+// NewWalletFromArmor allows to build a new Wallet instance using the private key contained inside the
+// given ASCII-armored string, encrypted with the provided passphrase (e.g. the output of the keys export command)
+func NewWalletFromArmor(armor string, passphrase string, client Client) (*Wallet, error) {
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("error while decrypting armored private key: %s", err)
+	}
+
+	return NewWalletFromSigner(NewPrivKeySigner(privKey), client), nil
+}
+
+// NewWalletFromArmorFile allows to build a new Wallet instance using the private key contained inside the
+// ASCII-armored file having the given path, encrypted with the provided passphrase
+func NewWalletFromArmorFile(path string, passphrase string, client Client) (*Wallet, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading armor file: %s", err)
+	}
+
+	return NewWalletFromArmor(string(bz), passphrase, client)
+}
+
+// NewWalletsRange allows to build count Wallet instances using the same mnemonic, deriving each
+// key from the HD path of the given configuration with the address index starting from startIndex
+func NewWalletsRange(accountCfg *types.AccountConfig, startIndex uint32, count uint32, client Client) ([]*Wallet, error) {
+	wallets := make([]*Wallet, count)
+	for i := uint32(0); i < count; i++ {
+		indexCfg, err := accountCfg.WithAddressIndex(startIndex + i)
+		if err != nil {
+			return nil, err
+		}
+
+		wallets[i], err = NewWallet(indexCfg, client)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating wallet with index %d: %s", startIndex+i, err)
+		}
+	}
+
+	return wallets, nil
+}
+
+// NewWalletFromKeyring allows to build a new Wallet instance that signs transactions
+// using the key having the given name stored inside the provided keyring
+func NewWalletFromKeyring(kr keyring.Keyring, keyName string, client Client) (*Wallet, error) {
+	signer, err := NewKeyringSigner(kr, keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWalletFromSigner(signer, client), nil
+}
+
+// NewWalletFromKeyringConfig allows to build a new Wallet instance that signs transactions using
+// the key stored inside the keyring described by the given configuration.
+// The user input is used by the file backend to read the keyring passphrase.
+func NewWalletFromKeyringConfig(keyringCfg *types.KeyringConfig, cdc codec.Codec, userInput io.Reader, client Client) (*Wallet, error) {
+	kr, err := types.CreateKeyring(keyringCfg, cdc, userInput)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating keyring: %s", err)
+	}
+
+	return NewWalletFromKeyring(kr, keyringCfg.KeyName, client)
+}
+
+// NewWalletFromSigner allows to build a new Wallet instance that uses the given signer to sign transactions
+func NewWalletFromSigner(signer Signer, client Client) *Wallet {
 	return &Wallet{
-		privKey: algo.Generate()(derivedPriv),
+		signer:  signer,
 		client:  client,
-	}, nil
+		account: newAccountState(),
+	}
+}
+
+// Signer returns the signer that is used to sign the transactions
+func (w *Wallet) Signer() Signer {
+	return w.signer
+}
+
+// ExportPrivKeyArmor exports the private key of this wallet in the ASCII-armored format, encrypting it
+// with the given passphrase. The result can be imported using the keys import command.
+// An error is returned if the wallet signer does not implement ArmorExporter
+func (w *Wallet) ExportPrivKeyArmor(passphrase string) (string, error) {
+	exporter, ok := w.signer.(ArmorExporter)
+	if !ok {
+		return "", fmt.Errorf("signer of type %T does not support exporting the private key", w.signer)
+	}
+
+	return exporter.ExportPrivKeyArmor(passphrase)
 }
 
 // AccAddress returns the address of the account that is going to be used to sign the transactions
 func (w *Wallet) AccAddress() string {
-	bech32Addr, err := bech32.ConvertAndEncode(w.client.GetAccountPrefix(), w.privKey.PubKey().Address())
+	bech32Addr, err := bech32.ConvertAndEncode(w.client.GetAccountPrefix(), w.signer.Address())
 	if err != nil {
 		panic(err)
 	}
@@ -49,6 +149,23 @@ func (w *Wallet) AccAddress() string {
 
 // getTransactionResponse builds a transactions from the provided data and broadcasts it using the provided method
 func (w *Wallet) getTransactionResponse(data *types.TransactionData, broadcast types.TxBroadcastMethod) (types.TransactionResponse, error) {
+	w.account.mu.Lock()
+	defer w.account.mu.Unlock()
+
+	var response types.TransactionResponse
+	var err error
+	if w.isSequenceTracked(data) {
+		response, err = w.getTrackedTransactionResponse(data, broadcast)
+	} else {
+		response, err = w.buildAndBroadcastTx(data, broadcast)
+	}
+
+	return w.retryWithExpectedSequence(data, broadcast, response, err)
+}
+
+// buildAndBroadcastTx creates and signs a transaction with the provided data, and then broadcasts it using
+// the given method
+func (w *Wallet) buildAndBroadcastTx(data *types.TransactionData, broadcast types.TxBroadcastMethod) (types.TransactionResponse, error) {
 	response := types.NewTransactionResponse()
 
 	account, builder, err := w.BuildTx(data)
@@ -90,101 +207,36 @@ func (w *Wallet) BroadcastTxCommit(data *types.TransactionData) (types.Transacti
 	return w.getTransactionResponse(data, w.client.BroadcastTxCommit)
 }
 
-// BuildTx creates a transaction with the provided data
-func (w *Wallet) BuildTx(data *types.TransactionData) (sdk.AccountI, sdkclient.TxBuilder, error) {
-	// Get the account
-	account, err := w.client.GetAccount(w.AccAddress())
-	if err != nil {
-		return nil, nil, fmt.Errorf("error while getting the account from the chain: %s", err)
-	}
-
-	// Set account sequence
-	if data.Sequence != nil && *data.Sequence > 0 {
-		err = account.SetSequence(*data.Sequence)
-		if err != nil {
-			return nil, nil, fmt.Errorf("error while setting the account sequence: %s", err)
-		}
-	}
-
-	// Build the transaction
-	builder := w.client.GetTxConfig().NewTxBuilder()
-	if data.Memo != "" {
-		builder.SetMemo(data.Memo)
-	}
-	if data.FeeGranter != nil {
-		builder.SetFeeGranter(data.FeeGranter)
-	}
-
-	if len(data.Messages) == 0 {
-		return nil, nil, fmt.Errorf("error while building a transaction with no messages")
-	}
-
-	err = builder.SetMsgs(data.Messages...)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	gasLimit := data.GasLimit
-	if data.GasAuto {
-		adjusted, err := w.simulateTx(account, builder)
-		if err != nil {
-			return nil, nil, err
-		}
-		gasLimit = adjusted
-	}
-
-	feeAmount := data.FeeAmount
-	if data.FeeAuto {
-		// Compute the fee amount based on the gas limit and the gas price
-		feeAmount = w.client.GetFees(int64(gasLimit))
-	}
-
-	// Set the new gas and fee
-	builder.SetGasLimit(gasLimit)
-	builder.SetFeeAmount(feeAmount)
-
-	// Set an empty signature first
-	sigData := signing.SingleSignatureData{
-		SignMode: signing.SignMode_SIGN_MODE_DIRECT,
-	}
-	sig := signing.SignatureV2{
-		PubKey:   w.privKey.PubKey(),
-		Data:     &sigData,
-		Sequence: account.GetSequence(),
+// BroadcastTxAndWait creates and signs a transaction with the provided messages and fees, broadcasts it using the
+// sync method and then waits for it to be included inside a block. The returned response contains the result of
+// the transaction execution, including its height and events. An error is returned if the transaction is not
+// included before the given context is done.
+// This should be preferred to BroadcastTxCommit, which is deprecated and could time out on busy nodes
+func (w *Wallet) BroadcastTxAndWait(ctx context.Context, data *types.TransactionData) (types.TransactionResponse, error) {
+	// The account lock is held only while broadcasting, so that other transactions
+	// can be broadcasted while waiting for this one to be included
+	response, err := w.BroadcastTxSync(data)
+	if err != nil || response.Code != 0 {
+		return response, err
 	}
 
-	err = builder.SetSignatures(sig)
+	txResponse, err := w.client.WaitForTx(ctx, response.TxHash)
 	if err != nil {
-		return nil, nil, err
+		return response, fmt.Errorf("error while waiting for the transaction: %s", err)
 	}
 
-	chainID, err := w.client.GetChainID()
-	if err != nil {
-		return nil, nil, err
-	}
+	return response.WithResponse(txResponse), nil
+}
 
-	// Sign the transaction with the private key
-	sig, err = tx.SignWithPrivKey(
-		// Since we are only signing using the DIRECT method, the context
-		// here is not important as it's only used for TEXT signing
-		context.Background(),
-
-		signing.SignMode_SIGN_MODE_DIRECT,
-		authsigning.SignerData{
-			ChainID:       chainID,
-			AccountNumber: account.GetAccountNumber(),
-			Sequence:      account.GetSequence(),
-		},
-		builder,
-		w.privKey,
-		w.client.GetTxConfig(),
-		account.GetSequence(),
-	)
+// BuildTx creates a transaction with the provided data
+func (w *Wallet) BuildTx(data *types.TransactionData) (sdk.AccountI, sdkclient.TxBuilder, error) {
+	account, builder, signerData, err := w.buildUnsignedTx(data)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	err = builder.SetSignatures(sig)
+	// Sign the transaction using the signer
+	err = signTx(w.client, builder, data.GetSignMode(), []Signer{w.signer}, []authsigning.SignerData{signerData})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -192,30 +244,36 @@ func (w *Wallet) BuildTx(data *types.TransactionData) (sdk.AccountI, sdkclient.T
 	return account, builder, nil
 }
 
-// simulateTx simulates the given transaction and returns the amount of adjusted gas that should be used
-func (w *Wallet) simulateTx(account sdk.AccountI, builder sdkclient.TxBuilder) (uint64, error) {
-	// Create an empty signature literal as the ante handler will populate with a
-	// sentinel pubkey.
-	sig := signing.SignatureV2{
-		PubKey: &secp256k1.PubKey{},
-		Data: &signing.SingleSignatureData{
-			SignMode: signing.SignMode_SIGN_MODE_DIRECT,
-		},
-		Sequence: account.GetSequence(),
+// EstimateGas simulates a transaction containing the given messages and returns the amount of gas
+// that it would consume, already adjusted using the client gas adjustment
+func (w *Wallet) EstimateGas(msgs ...sdk.Msg) (uint64, error) {
+	data := types.NewTransactionData(msgs...).WithGasAuto()
+
+	// Make sure the simulation uses the same sequence that the next transaction will use
+	if w.trackSequence {
+		tracker := w.account.sequenceTracker
+		tracker.mu.Lock()
+		sequence, err := tracker.getSequence(w.client, w.AccAddress())
+		tracker.mu.Unlock()
+		if err != nil {
+			return 0, err
+		}
+		data = data.WithSequence(sequence)
 	}
-	err := builder.SetSignatures(sig)
+
+	_, builder, _, err := w.buildUnsignedTx(data)
 	if err != nil {
 		return 0, err
 	}
+	return builder.GetTx().GetGas(), nil
+}
 
-	// Set a fake amount of gas and fees
-	builder.SetGasLimit(200_000)
-	builder.SetFeeAmount(w.client.GetFees(int64(200_000)))
-
-	// Simulate the execution of the transaction
-	adjusted, err := w.client.SimulateTx(builder.GetTx())
+// buildUnsignedTx creates a transaction with the provided data, setting an empty signature for the wallet signer.
+// It returns the account that should sign the transaction, the builder and the data to be used when signing it
+func (w *Wallet) buildUnsignedTx(data *types.TransactionData) (sdk.AccountI, sdkclient.TxBuilder, authsigning.SignerData, error) {
+	accounts, builder, signersData, err := buildUnsignedTx(w.client, []cryptotypes.PubKey{w.signer.PubKey()}, data)
 	if err != nil {
-		return 0, fmt.Errorf("error while simulating tx: %s", err)
+		return nil, nil, authsigning.SignerData{}, err
 	}
-	return adjusted, nil
+	return accounts[0], builder, signersData[0], nil
 }
@@ -92,7 +92,7 @@ func (suite *WalletTestSuite) TestBuildTx() {
 				tc.msgs...,
 			).WithGasAuto().WithFeeAuto().WithMemo("Custom memo").WithSequence(0)
 
-			builder, err := suite.wallet.BuildTx(data)
+			_, builder, err := suite.wallet.BuildTx(data)
 			if tc.shouldErr {
 				suite.Require().Error(err)
 			} else {